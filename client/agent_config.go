@@ -0,0 +1,146 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"GoMonitor/client/collectors"
+	"GoMonitor/pkg/config"
+	"GoMonitor/proto"
+)
+
+// ConfigCommandType 是服务端下发配置时使用的命令类型
+const ConfigCommandType = "config"
+
+// 服务端下发的配置覆盖本地配置中对应的已设置字段，列表字段以 *_set 标记是否设置
+// （兼容未发送标记的旧服务端，非空列表也视为已设置）；
+// 命令策略例外，只能在本地策略基础上进一步收紧，避免服务端放开本地禁止的命令
+
+// ApplyAgentConfig 应用服务端下发的配置并向服务端确认
+func (c *Client) ApplyAgentConfig(agentCfg *proto.AgentConfig) {
+	err := c.applyAgentConfig(agentCfg)
+	if err != nil {
+		log.Printf("应用服务端配置版本 %d 失败: %v", agentCfg.GetVersion(), err)
+	} else {
+		log.Printf("已应用服务端配置版本 %d，上报间隔: %v", agentCfg.GetVersion(), c.Interval())
+	}
+
+	go c.ackConfig(agentCfg.GetVersion(), err)
+}
+
+func (c *Client) applyAgentConfig(agentCfg *proto.AgentConfig) error {
+	if agentCfg == nil {
+		return nil
+	}
+
+	overlay := config.AgentConfigOverlay{
+		Interval:            time.Duration(agentCfg.IntervalSeconds) * time.Second,
+		Collectors:          agentCfg.EnabledCollectors,
+		AllowedCommandTypes: agentCfg.AllowedCommandTypes,
+	}
+	if err := overlay.Validate("agent_config"); err != nil {
+		return err
+	}
+
+	c.cfgMu.Lock()
+	// 命令流重连时服务端可能重发旧配置，只接受更新的版本
+	if c.agentCfg != nil && agentCfg.Version < c.agentCfg.Version {
+		c.cfgMu.Unlock()
+		return nil
+	}
+	c.agentCfg = agentCfg
+	c.cfgMu.Unlock()
+
+	c.logRules.SetRules(agentCfg.LogRules)
+	c.syncCollectorSettings()
+	c.notifyIntervalChanged()
+	return nil
+}
+
+// ackConfig 向服务端确认配置应用结果
+func (c *Client) ackConfig(version int64, applyErr error) {
+	ack := &proto.ConfigAck{
+		ClientId: c.ClientID(),
+		Version:  version,
+		Success:  applyErr == nil,
+	}
+	if applyErr != nil {
+		ack.Error = applyErr.Error()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if _, err := c.client.AckConfig(ctx, ack); err != nil {
+		log.Printf("确认配置版本 %d 失败: %v", version, err)
+	}
+}
+
+// notifyIntervalChanged 通知主循环重新设置上报间隔
+func (c *Client) notifyIntervalChanged() {
+	select {
+	case c.intervalChanged <- struct{}{}:
+	default:
+	}
+}
+
+// IntervalChanged 返回上报间隔可能发生变化时触发的通道
+func (c *Client) IntervalChanged() <-chan struct{} {
+	return c.intervalChanged
+}
+
+// Interval 返回当前生效的上报间隔
+func (c *Client) Interval() time.Duration {
+	c.cfgMu.RLock()
+	defer c.cfgMu.RUnlock()
+
+	if c.agentCfg.GetIntervalSeconds() > 0 {
+		return time.Duration(c.agentCfg.IntervalSeconds) * time.Second
+	}
+	return c.cfg.Interval
+}
+
+// CollectorEnabled 判断采集器当前是否启用
+func (c *Client) CollectorEnabled(name string) bool {
+	c.cfgMu.RLock()
+	defer c.cfgMu.RUnlock()
+
+	if c.agentCfg.GetEnabledCollectorsSet() || len(c.agentCfg.GetEnabledCollectors()) > 0 {
+		for _, collector := range c.agentCfg.EnabledCollectors {
+			if collector == name {
+				return true
+			}
+		}
+		return false
+	}
+	return c.cfg.CollectorEnabled(name)
+}
+
+// CommandAllowed 判断命令类型是否同时被本地策略和服务端策略允许
+func (c *Client) CommandAllowed(cmdType string) bool {
+	c.cfgMu.RLock()
+	defer c.cfgMu.RUnlock()
+
+	if !c.cfg.Policies.Allows(cmdType) {
+		return false
+	}
+
+	if c.agentCfg.GetAllowedCommandTypesSet() || len(c.agentCfg.GetAllowedCommandTypes()) > 0 {
+		return config.CommandPolicy{AllowedCommandTypes: c.agentCfg.AllowedCommandTypes}.Allows(cmdType)
+	}
+	return true
+}
+
+// CollectorOptions 返回当前生效的采集过滤选项
+func (c *Client) CollectorOptions() collectors.Options {
+	c.cfgMu.RLock()
+	defer c.cfgMu.RUnlock()
+
+	return collectors.Options{
+		InterfaceExclude:    c.agentCfg.GetInterfaceExclude(),
+		InterfaceExcludeSet: c.agentCfg.GetInterfaceExcludeSet(),
+		PartitionExclude:    c.agentCfg.GetPartitionExclude(),
+		Filters:             c.filters,
+	}
+}
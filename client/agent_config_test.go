@@ -0,0 +1,38 @@
+package main
+
+import (
+	"testing"
+
+	"GoMonitor/pkg/config"
+	"GoMonitor/proto"
+)
+
+func TestAgentConfigListPresence(t *testing.T) {
+	local := &config.ClientConfig{
+		Collectors: []string{"cpu", "memory"},
+		Policies:   config.CommandPolicy{AllowedCommandTypes: []string{"shell", "collect_info"}},
+	}
+
+	tests := []struct {
+		name      string
+		agentCfg  *proto.AgentConfig
+		collector bool // cpu 是否启用
+		shell     bool // shell 命令是否允许
+	}{
+		{"未下发配置", nil, true, true},
+		{"未设置列表", &proto.AgentConfig{}, true, true},
+		{"设置为空列表", &proto.AgentConfig{EnabledCollectorsSet: true, AllowedCommandTypesSet: true}, false, false},
+		{"旧服务端的非空列表", &proto.AgentConfig{EnabledCollectors: []string{"disk"}, AllowedCommandTypes: []string{"collect_info"}}, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{cfg: local, agentCfg: tt.agentCfg}
+			if got := c.CollectorEnabled("cpu"); got != tt.collector {
+				t.Errorf("CollectorEnabled(cpu) = %v, 期望 %v", got, tt.collector)
+			}
+			if got := c.CommandAllowed("shell"); got != tt.shell {
+				t.Errorf("CommandAllowed(shell) = %v, 期望 %v", got, tt.shell)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"math/rand"
+	"time"
+)
+
+// Backoff 实现带抖动的指数退避，用于各类重连循环
+type Backoff struct {
+	Min     time.Duration
+	Max     time.Duration
+	Factor  float64
+	attempt int
+}
+
+// NewBackoff 创建退避器，每次失败后等待时间按factor倍增，不超过max
+func NewBackoff(min, max time.Duration) *Backoff {
+	return &Backoff{
+		Min:    min,
+		Max:    max,
+		Factor: 2,
+	}
+}
+
+// Next 返回下一次重试前应等待的时间
+// 采用"等抖动"策略：在当前退避值的一半到全部之间随机取值，避免大量客户端同时重连
+func (b *Backoff) Next() time.Duration {
+	d := float64(b.Min)
+	for i := 0; i < b.attempt; i++ {
+		d *= b.Factor
+		if d >= float64(b.Max) {
+			d = float64(b.Max)
+			break
+		}
+	}
+	b.attempt++
+
+	half := d / 2
+	return time.Duration(half + rand.Float64()*half)
+}
+
+// Wait 阻塞等待下一次退避时间
+func (b *Backoff) Wait() {
+	time.Sleep(b.Next())
+}
+
+// Reset 在操作成功后重置退避状态
+func (b *Backoff) Reset() {
+	b.attempt = 0
+}
@@ -5,66 +5,361 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"reflect"
 	"sync"
 	"time"
 
 	"GoMonitor/client/collectors"
+	"GoMonitor/pkg/config"
+	"GoMonitor/pkg/models"
 	"GoMonitor/pkg/utils"
 	"GoMonitor/proto"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
 )
 
+// inventoryCheckInterval 是重新收集硬件和系统清单以检查变化的间隔
+const inventoryCheckInterval = 10 * time.Minute
+
 // Client 表示客户端实例
 type Client struct {
 	clientID    string
+	idMu        sync.RWMutex
+	registerMu  sync.Mutex
 	serverConn  *grpc.ClientConn
 	client      proto.SystemInfoServiceClient
 	mu          sync.Mutex
 	cmdResults  map[string]*proto.CommandResult
 	cmdExecutor *CommandExecutor
+	registry    *collectors.Registry
+	plugins     *collectors.ExecPluginCollector
+	checks      *collectors.CheckCollector
+	packages    *collectors.PackageCollector
+	integrity   *collectors.IntegrityCollector
+	logins      *collectors.LoginCollector
+	logs        *LogForwarder
+	logRules    *LogRuleEvaluator
+	cfgMu       sync.RWMutex
+	cfg         *config.ClientConfig
+	agentCfg    *proto.AgentConfig // 服务端下发的配置
+	filters     collectors.Filters // 由本地配置编译的过滤规则
+
+	inventoryMu        sync.Mutex
+	inventory          *proto.HostInventory // 服务端已接收的硬件和系统清单
+	inventoryCheckedAt time.Time
+
+	intervalChanged chan struct{}
+	reportRequested chan struct{}
 }
 
 // NewClient 创建新的客户端实例
-func NewClient(serverAddr string) (*Client, error) {
-	conn, err := grpc.Dial(serverAddr, grpc.WithInsecure())
+func NewClient(cfg *config.ClientConfig) (*Client, error) {
+	creds := insecure.NewCredentials()
+	if cfg.TLS.Enabled {
+		tlsConfig, err := cfg.TLS.Build()
+		if err != nil {
+			return nil, err
+		}
+		creds = credentials.NewTLS(tlsConfig)
+	}
+
+	filters, err := buildFilters(cfg.Filters)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := grpc.Dial(cfg.Server, grpc.WithTransportCredentials(creds))
 	if err != nil {
 		return nil, fmt.Errorf("无法连接到服务器: %v", err)
 	}
 
 	client := &Client{
-		serverConn: conn,
-		client:     proto.NewSystemInfoServiceClient(conn),
-		cmdResults: make(map[string]*proto.CommandResult),
+		cfg:             cfg,
+		filters:         filters,
+		intervalChanged: make(chan struct{}, 1),
+		reportRequested: make(chan struct{}, 1),
+		registry:        collectors.NewDefaultRegistry(),
+		plugins:         collectors.NewExecPluginCollector(),
+		checks:          collectors.NewCheckCollector(),
+		packages:        collectors.NewPackageCollector(cfg.Packages.Pip, cfg.Packages.Npm),
+		serverConn:      conn,
+		client:          proto.NewSystemInfoServiceClient(conn),
+		cmdResults:      make(map[string]*proto.CommandResult),
 	}
 
 	client.cmdExecutor = NewCommandExecutor(client)
+	client.registry.Register(client.plugins)
+	client.plugins.SetPlugins(pluginSpecs(cfg.Plugins))
+	client.registry.Register(client.checks)
+	client.checks.SetChecks(checkSpecs(cfg.Checks))
+	client.registry.Register(collectors.NewCgroupCollector(cfg.Cgroups.Root, cfg.Cgroups.DockerSocket))
+	client.registry.Register(collectors.NewSystemdCollector(cfg.Systemd.Units, cfg.Systemd.IncludeFailed))
+	client.registry.Register(client.packages)
+	client.integrity = collectors.NewIntegrityCollector(integritySpec(cfg.Integrity), client.integrityChanged)
+	client.registry.Register(client.integrity)
+	client.logins = collectors.NewLoginCollector(loginSpec(cfg.Logins))
+	client.registry.Register(client.logins)
+	client.registry.Register(collectors.NewAccountCollector(""))
+	client.registry.Register(collectors.NewCronCollector(""))
+	client.syncCollectorSettings()
+	client.logs = NewLogForwarder(client)
+	client.logs.Apply(cfg.Logs)
+	client.logRules = NewLogRuleEvaluator()
 
 	return client, nil
 }
 
 // Close 关闭客户端连接
 func (c *Client) Close() {
+	c.plugins.Stop()
+	c.checks.Stop()
+	c.integrity.Stop()
+	c.logs.Stop()
+	c.logRules.Stop()
+
 	if c.serverConn != nil {
 		c.serverConn.Close()
 	}
 }
 
-// Register 注册客户端到服务器
-func (c *Client) Register() error {
+// Config 返回当前生效的配置，调用方不应修改返回值
+func (c *Client) Config() *config.ClientConfig {
+	c.cfgMu.RLock()
+	defer c.cfgMu.RUnlock()
+	return c.cfg
+}
+
+// ApplyConfig 应用重新加载的本地配置
+// 上报间隔、采集器、命令策略立即生效（服务端下发的配置优先），标签在下次注册时生效；服务器地址和TLS需要重启客户端
+func (c *Client) ApplyConfig(cfg *config.ClientConfig) {
+	filters, err := buildFilters(cfg.Filters)
+
+	c.cfgMu.Lock()
+	old := c.cfg
+	c.cfg = cfg
+	if err == nil {
+		c.filters = filters
+	}
+	c.cfgMu.Unlock()
+
+	if err != nil {
+		log.Printf("过滤规则无效，继续使用原有规则: %v", err)
+	}
+
+	if old.Server != cfg.Server || old.TLS != cfg.TLS {
+		log.Printf("服务器地址或TLS配置已变更，需要重启客户端才能生效")
+	}
+
+	if !reflect.DeepEqual(old.Plugins, cfg.Plugins) {
+		c.plugins.SetPlugins(pluginSpecs(cfg.Plugins))
+	}
+
+	if !reflect.DeepEqual(old.Checks, cfg.Checks) {
+		c.checks.SetChecks(checkSpecs(cfg.Checks))
+	}
+
+	if old.Cgroups != cfg.Cgroups {
+		c.registry.Register(collectors.NewCgroupCollector(cfg.Cgroups.Root, cfg.Cgroups.DockerSocket))
+	}
+
+	if !reflect.DeepEqual(old.Systemd, cfg.Systemd) {
+		c.registry.Register(collectors.NewSystemdCollector(cfg.Systemd.Units, cfg.Systemd.IncludeFailed))
+	}
+
+	if old.Packages != cfg.Packages {
+		c.packages.SetSources(cfg.Packages.Pip, cfg.Packages.Npm)
+	}
+
+	if !reflect.DeepEqual(old.Integrity, cfg.Integrity) {
+		c.integrity.SetSpec(integritySpec(cfg.Integrity))
+		c.registry.Invalidate(c.integrity.Name())
+	}
+
+	if !reflect.DeepEqual(old.Logins, cfg.Logins) {
+		c.logins.SetSpec(loginSpec(cfg.Logins))
+		c.registry.Invalidate(c.logins.Name())
+	}
+
+	c.logs.Apply(cfg.Logs)
+
+	c.syncCollectorSettings()
+	c.notifyIntervalChanged()
+}
+
+// syncCollectorSettings 根据当前生效的配置更新采集器注册表
+func (c *Client) syncCollectorSettings() {
+	cfg := c.Config()
+	for _, name := range c.registry.Names() {
+		settings := cfg.CollectorSettings[name]
+		c.registry.Configure(name, collectors.Settings{
+			Enabled:  c.CollectorEnabled(name),
+			Interval: settings.Interval,
+			Timeout:  settings.Timeout,
+		})
+	}
+}
+
+// buildFilters 编译配置中的接口和分区过滤规则
+func buildFilters(cfg config.FilterConfig) (collectors.Filters, error) {
+	var filters collectors.Filters
+	var err error
+	if filters.Interfaces, err = newMatcher("filters.interfaces.name", cfg.Interfaces.Name); err != nil {
+		return filters, err
+	}
+	if filters.MountPoints, err = newMatcher("filters.partitions.mount_point", cfg.Partitions.MountPoint); err != nil {
+		return filters, err
+	}
+	if filters.Fstypes, err = newMatcher("filters.partitions.fstype", cfg.Partitions.Fstype); err != nil {
+		return filters, err
+	}
+	if filters.Devices, err = newMatcher("filters.partitions.device", cfg.Partitions.Device); err != nil {
+		return filters, err
+	}
+	return filters, nil
+}
+
+// newMatcher 编译一组包含和排除规则，没有任何规则时返回nil
+func newMatcher(field string, rules config.MatchRules) (*utils.Matcher, error) {
+	if len(rules.Include) == 0 && len(rules.Exclude) == 0 {
+		return nil, nil
+	}
+	m, err := utils.NewMatcher(rules.Include, rules.Exclude)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", field, err)
+	}
+	return m, nil
+}
+
+// pluginSpecs 将配置转换为插件采集器使用的描述
+func pluginSpecs(plugins []config.PluginConfig) []collectors.PluginSpec {
+	specs := make([]collectors.PluginSpec, len(plugins))
+	for i, p := range plugins {
+		specs[i] = collectors.PluginSpec{
+			Name:     p.Name,
+			Command:  p.Command,
+			Args:     p.Args,
+			Interval: p.Interval,
+			Timeout:  p.Timeout,
+			Format:   p.Format,
+		}
+	}
+	return specs
+}
+
+// checkSpecs 将配置转换为检查采集器使用的描述
+func checkSpecs(checks []config.CheckConfig) []collectors.CheckSpec {
+	specs := make([]collectors.CheckSpec, len(checks))
+	for i, check := range checks {
+		specs[i] = collectors.CheckSpec{
+			Name:     check.Name,
+			Command:  check.Command,
+			Args:     check.Args,
+			Interval: check.Interval,
+			Timeout:  check.Timeout,
+		}
+	}
+	return specs
+}
+
+// integritySpec 将配置转换为文件完整性采集器使用的描述
+func integritySpec(cfg config.IntegrityConfig) collectors.IntegritySpec {
+	return collectors.IntegritySpec{
+		Paths:        cfg.Paths,
+		Exclude:      cfg.Exclude,
+		BaselineFile: cfg.BaselineFile,
+		Inotify:      cfg.Inotify,
+	}
+}
+
+// loginSpec 将配置转换为登录审计采集器使用的描述
+func loginSpec(cfg config.LoginConfig) collectors.LoginSpec {
+	return collectors.LoginSpec{
+		AuthLogs: cfg.AuthLogs,
+		Window:   cfg.Window,
+		History:  cfg.History,
+	}
+}
+
+// integrityChanged 在inotify发现监控的文件变化后丢弃缓存的扫描结果并立即上报
+func (c *Client) integrityChanged() {
+	c.registry.Invalidate(c.integrity.Name())
+	c.RequestReport()
+}
+
+// RequestReport 请求主循环立即上报一次系统信息，已有未处理的请求时合并
+func (c *Client) RequestReport() {
+	select {
+	case c.reportRequested <- struct{}{}:
+	default:
+	}
+}
+
+// ReportRequested 返回需要立即上报时触发的通道
+func (c *Client) ReportRequested() <-chan struct{} {
+	return c.reportRequested
+}
+
+// ClientID 返回服务端分配的客户端ID
+func (c *Client) ClientID() string {
+	c.idMu.RLock()
+	defer c.idMu.RUnlock()
+	return c.clientID
+}
+
+// isUnknownClientError 判断错误是否表示服务端已不认识该客户端（例如服务端重启）
+func isUnknownClientError(err error) bool {
+	return status.Code(err) == codes.NotFound
+}
+
+// hostIdentity 是注册和每次上报时携带的主机身份信息
+type hostIdentity struct {
+	hostname    string
+	ipAddress   string
+	ipAddresses []*proto.IPAddress
+	macAddress  string
+	osInfo      string
+}
+
+// currentIdentity 读取主机当前的身份信息
+func currentIdentity() hostIdentity {
 	hostname, err := os.Hostname()
 	if err != nil {
 		hostname = "unknown"
 	}
 
-	ipAddr := utils.GetLocalIPv4()
-	macAddr := utils.GetLocalMACAddress()
-	osInfo := utils.GetOSInfo()
+	addrs := utils.GetLocalIPAddresses()
+	ipAddr := utils.PrimaryIPAddress(addrs)
+	if ipAddr == "" {
+		ipAddr = "unknown"
+	}
+
+	return hostIdentity{
+		hostname:    hostname,
+		ipAddress:   ipAddr,
+		ipAddresses: models.IPAddressesFromNets(addrs),
+		macAddress:  utils.GetLocalMACAddress(),
+		osInfo:      utils.GetOSInfo(),
+	}
+}
+
+// Register 注册客户端到服务器
+func (c *Client) Register() error {
+	identity := currentIdentity()
+	inventory := collectors.CollectInventory(context.Background(), "")
 
 	req := &proto.RegisterRequest{
-		Hostname:   hostname,
-		IpAddress:  ipAddr,
-		MacAddress: macAddr,
-		OsInfo:     osInfo,
+		Hostname:    identity.hostname,
+		IpAddress:   identity.ipAddress,
+		IpAddresses: identity.ipAddresses,
+		MacAddress:  identity.macAddress,
+		OsInfo:      identity.osInfo,
+		ClientId:    c.ClientID(),
+		Labels:      c.Config().Labels,
+		Inventory:   inventory,
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -72,68 +367,104 @@ func (c *Client) Register() error {
 
 	resp, err := c.client.Register(ctx, req)
 	if err != nil {
-		return fmt.Errorf("注册失败: %v", err)
+		return fmt.Errorf("注册失败: %w", err)
 	}
 
 	if !resp.Success {
 		return fmt.Errorf("注册被拒绝: %s", resp.Message)
 	}
 
+	c.idMu.Lock()
 	c.clientID = resp.ClientId
-	log.Printf("客户端注册成功，ID: %s", c.clientID)
+	c.idMu.Unlock()
+	c.inventorySent(inventory)
+
+	log.Printf("客户端注册成功，ID: %s", resp.ClientId)
 	return nil
 }
 
-// CollectSystemInfo 收集当前系统信息
-func (c *Client) CollectSystemInfo() (*proto.SystemInfo, error) {
-	sysInfo := &proto.SystemInfo{}
+// RegisterWithRetry 持续尝试注册直到成功，失败时按指数退避重试
+func (c *Client) RegisterWithRetry() {
+	backoff := NewBackoff(time.Second, time.Minute)
+	for {
+		err := c.Register()
+		if err == nil {
+			return
+		}
 
-	cpuInfo, err := collectors.CollectCPUInfo()
-	if err != nil {
-		return nil, fmt.Errorf("收集CPU信息失败: %v", err)
+		wait := backoff.Next()
+		log.Printf("注册失败: %v，%v后重试", err, wait.Round(time.Millisecond))
+		time.Sleep(wait)
 	}
-	sysInfo.CpuInfo = cpuInfo
+}
 
-	memInfo, err := collectors.CollectMemoryInfo()
-	if err != nil {
-		return nil, fmt.Errorf("收集内存信息失败: %v", err)
-	}
-	sysInfo.MemoryInfo = memInfo
+// reregister 在服务端不再识别staleID时重新注册
+// 多个goroutine可能同时发现ID失效，只有第一个会真正执行注册，其余直接复用新ID
+func (c *Client) reregister(staleID string) {
+	c.registerMu.Lock()
+	defer c.registerMu.Unlock()
 
-	diskInfo, err := collectors.CollectDiskInfo()
-	if err != nil {
-		return nil, fmt.Errorf("收集磁盘信息失败: %v", err)
+	if c.ClientID() != staleID {
+		return
 	}
-	sysInfo.DiskInfo = diskInfo
 
-	netInfo, err := collectors.CollectNetworkInfo()
-	if err != nil {
-		return nil, fmt.Errorf("收集网络信息失败: %v", err)
+	log.Printf("服务端不再识别客户端ID %s，重新注册", staleID)
+	c.RegisterWithRetry()
+}
+
+// CollectSystemInfo 并行运行所有启用的采集器收集当前系统信息
+// 单个采集器失败时仍返回其余采集器的结果，失败原因记录在CollectorErrors中
+// peek为true时用于按需查询，不会开始新的统计窗口
+func (c *Client) CollectSystemInfo(ctx context.Context, peek bool) *proto.SystemInfo {
+	opts := c.CollectorOptions()
+	opts.Peek = peek
+	sysInfo := c.registry.CollectAll(ctx, opts)
+
+	for _, collectorErr := range sysInfo.CollectorErrors {
+		log.Printf("采集器 %s 失败: %s", collectorErr.Collector, collectorErr.Error)
 	}
-	sysInfo.NetworkInfo = netInfo
 
 	// 添加自定义指标
-	sysInfo.CustomMetrics = make(map[string]string)
+	if sysInfo.CustomMetrics == nil {
+		sysInfo.CustomMetrics = make(map[string]string)
+	}
 	sysInfo.CustomMetrics["uptime"] = fmt.Sprintf("%d", utils.GetUptime())
+	sysInfo.LogRuleResults = c.logRules.Results()
 
-	return sysInfo, nil
+	return sysInfo
 }
 
 // SendSystemInfo 发送系统信息到服务器
+// 若服务端已遗忘该客户端，会先重新注册再重发一次
 func (c *Client) SendSystemInfo() error {
-	if c.clientID == "" {
+	clientID := c.ClientID()
+	if clientID == "" {
 		return fmt.Errorf("客户端未注册")
 	}
 
-	sysInfo, err := c.CollectSystemInfo()
-	if err != nil {
-		return err
+	sysInfo := c.CollectSystemInfo(context.Background(), false)
+
+	err := c.sendSystemInfo(clientID, sysInfo)
+	if isUnknownClientError(err) {
+		c.reregister(clientID)
+		err = c.sendSystemInfo(c.ClientID(), sysInfo)
 	}
+	return err
+}
 
+// sendSystemInfo 以指定的客户端ID发送一次系统信息
+func (c *Client) sendSystemInfo(clientID string, sysInfo *proto.SystemInfo) error {
+	identity := currentIdentity()
 	req := &proto.SystemInfoRequest{
-		ClientId:   c.clientID,
-		SystemInfo: sysInfo,
-		Timestamp:  time.Now().Unix(),
+		ClientId:    clientID,
+		SystemInfo:  sysInfo,
+		Timestamp:   time.Now().Unix(),
+		Inventory:   c.changedInventory(),
+		Hostname:    identity.hostname,
+		IpAddress:   identity.ipAddress,
+		IpAddresses: identity.ipAddresses,
+		MacAddress:  identity.macAddress,
+		OsInfo:      identity.osInfo,
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -141,69 +472,140 @@ func (c *Client) SendSystemInfo() error {
 
 	resp, err := c.client.SendSystemInfo(ctx, req)
 	if err != nil {
-		return fmt.Errorf("发送系统信息失败: %v", err)
+		return fmt.Errorf("发送系统信息失败: %w", err)
 	}
 
 	if !resp.Received {
 		return fmt.Errorf("服务器拒绝接收系统信息: %s", resp.Message)
 	}
 
+	if req.Inventory != nil {
+		c.inventorySent(req.Inventory)
+	}
+	if resp.ResyncPackages {
+		log.Printf("服务端要求重新同步软件包清单")
+		c.packages.Resync()
+	}
 	return nil
 }
 
+// changedInventory 每隔inventoryCheckInterval重新收集一次清单，与服务端已接收的清单不同时返回新清单，否则返回nil
+func (c *Client) changedInventory() *proto.HostInventory {
+	c.inventoryMu.Lock()
+	defer c.inventoryMu.Unlock()
+
+	if time.Since(c.inventoryCheckedAt) < inventoryCheckInterval {
+		return nil
+	}
+	c.inventoryCheckedAt = time.Now()
+
+	inventory := collectors.CollectInventory(context.Background(), "")
+	if protobuf.Equal(inventory, c.inventory) {
+		return nil
+	}
+	log.Printf("硬件或系统清单发生变化，随本次系统信息上报")
+	return inventory
+}
+
+// inventorySent 记录服务端已接收的清单
+func (c *Client) inventorySent(inventory *proto.HostInventory) {
+	c.inventoryMu.Lock()
+	defer c.inventoryMu.Unlock()
+	c.inventory = inventory
+	c.inventoryCheckedAt = time.Now()
+}
+
 // StartReceivingCommands 启动命令接收流
+// 流中断时按指数退避重连；若服务端不再识别客户端ID，则先重新注册再重新打开命令流
 func (c *Client) StartReceivingCommands() {
 	go func() {
+		backoff := NewBackoff(time.Second, time.Minute)
 		for {
-			if c.clientID == "" {
-				time.Sleep(5 * time.Second)
+			clientID := c.ClientID()
+			if clientID == "" {
+				backoff.Wait()
 				continue
 			}
 
-			req := &proto.CommandRequest{
-				ClientId: c.clientID,
-			}
-
-			ctx := context.Background()
-			stream, err := c.client.ReceiveCommands(ctx, req)
-			if err != nil {
-				log.Printf("开始接收命令失败: %v", err)
-				time.Sleep(5 * time.Second)
+			err := c.receiveCommands(clientID, backoff)
+			if isUnknownClientError(err) {
+				c.reregister(clientID)
 				continue
 			}
 
-			log.Printf("已连接到命令流")
+			wait := backoff.Next()
+			log.Printf("命令流中断: %v，%v后重连", err, wait.Round(time.Millisecond))
+			time.Sleep(wait)
+		}
+	}()
+}
 
-			for {
-				cmd, err := stream.Recv()
-				if err != nil {
-					log.Printf("命令流中断: %v", err)
-					break
-				}
+// receiveCommands 打开命令流并持续接收命令，直到流出错
+func (c *Client) receiveCommands(clientID string, backoff *Backoff) error {
+	req := &proto.CommandRequest{
+		ClientId: clientID,
+	}
 
-				log.Printf("收到新命令: ID=%s, 类型=%s", cmd.CommandId, cmd.CommandType)
-				go c.cmdExecutor.ExecuteCommand(cmd)
-			}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 
-			time.Sleep(5 * time.Second)
+	stream, err := c.client.ReceiveCommands(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	// 服务端校验通过后会先发送响应头；拿不到响应头说明流已被拒绝，错误需通过Recv获取
+	if md, _ := stream.Header(); md == nil {
+		_, err := stream.Recv()
+		return err
+	}
+
+	backoff.Reset()
+	log.Printf("已连接到命令流")
+
+	for {
+		cmd, err := stream.Recv()
+		if err != nil {
+			return err
 		}
-	}()
+
+		// 配置命令按接收顺序同步应用，且不作为普通命令报告结果
+		if cmd.CommandType == ConfigCommandType {
+			c.ApplyAgentConfig(cmd.AgentConfig)
+			continue
+		}
+
+		log.Printf("收到新命令: ID=%s, 类型=%s", cmd.CommandId, cmd.CommandType)
+		go c.cmdExecutor.ExecuteCommand(cmd)
+	}
 }
 
 // ReportCommandResult 向服务器报告命令执行结果
 func (c *Client) ReportCommandResult(result *proto.CommandResult) {
-	for i := 0; i < 3; i++ {
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-		defer cancel()
+	const maxAttempts = 3
+	backoff := NewBackoff(2*time.Second, 30*time.Second)
 
+	for i := 0; i < maxAttempts; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		resp, err := c.client.ReportCommandResult(ctx, result)
+		cancel()
+
 		if err == nil && resp.Received {
 			log.Printf("命令结果报告成功: %s", result.CommandId)
 			return
 		}
 
-		log.Printf("命令结果报告失败 (尝试 %d/3): %v", i+1, err)
-		time.Sleep(2 * time.Second)
+		log.Printf("命令结果报告失败 (尝试 %d/%d): %v", i+1, maxAttempts, err)
+
+		if isUnknownClientError(err) {
+			// 服务端重启后已不存在该命令，重新注册后结果也无处归属，直接放弃
+			c.reregister(result.ClientId)
+			break
+		}
+
+		if i < maxAttempts-1 {
+			backoff.Wait()
+		}
 	}
 
 	log.Printf("命令结果报告最终失败: %s", result.CommandId)
@@ -0,0 +1,444 @@
+package collectors
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"GoMonitor/proto"
+)
+
+// DefaultEtcRoot 是账户和sudo配置所在的目录
+const DefaultEtcRoot = "/etc"
+
+// maxSudoersDepth 是sudoers文件嵌套包含的最大深度，与sudo的限制一致
+const maxSudoersDepth = 128
+
+var (
+	// listSeparator 用于去掉列表中逗号两侧的空白，使每个列表成为一个字段
+	listSeparator = regexp.MustCompile(`\s*,\s*`)
+	// sudoTagPattern 匹配命令前的标签，例如 NOPASSWD:、SETENV:
+	sudoTagPattern = regexp.MustCompile(`^[A-Z_]+:\s*`)
+	// sudoOptionPattern 匹配命令前的选项，例如 CWD=/tmp、ROLE=sysadm_r
+	sudoOptionPattern = regexp.MustCompile(`^[A-Z_]+=\S+\s+`)
+	// uidUserPattern 匹配以UID开头的用户规则
+	uidUserPattern = regexp.MustCompile(`^#\d+[\s,]`)
+)
+
+// AccountCollector 解析passwd、group、shadow（只读取密码有效期）和sudoers，上报本地账户、组和sudo权限
+type AccountCollector struct {
+	etcRoot string
+}
+
+// NewAccountCollector 创建账户采集器，etcRoot为空时使用DefaultEtcRoot
+func NewAccountCollector(etcRoot string) *AccountCollector {
+	if etcRoot == "" {
+		etcRoot = DefaultEtcRoot
+	}
+	return &AccountCollector{etcRoot: etcRoot}
+}
+
+// Name 返回采集器名称
+func (c *AccountCollector) Name() string {
+	return "accounts"
+}
+
+// Collect 实现Collector接口
+// 没有/etc/passwd的系统（例如Windows）不上报；shadow和sudoers通常只有root可读，不可读时相应字段为空
+func (c *AccountCollector) Collect(ctx context.Context, opts Options, info *proto.SystemInfo) error {
+	passwd, err := readColonFile(filepath.Join(c.etcRoot, "passwd"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	groupLines, err := readColonFile(filepath.Join(c.etcRoot, "group"))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	shadowLines, _ := readColonFile(filepath.Join(c.etcRoot, "shadow"))
+
+	inventory := &proto.AccountInventory{}
+	groupNames := make(map[uint32]string)
+	memberOf := make(map[string][]string) // 用户 -> 附加组
+	for _, fields := range groupLines {
+		if len(fields) < 4 {
+			continue
+		}
+		gid, err := strconv.ParseUint(fields[2], 10, 32)
+		if err != nil {
+			continue
+		}
+		group := &proto.LocalGroup{Name: fields[0], Gid: uint32(gid)}
+		for _, member := range strings.Split(fields[3], ",") {
+			if member = strings.TrimSpace(member); member != "" {
+				group.Members = append(group.Members, member)
+				memberOf[member] = append(memberOf[member], group.Name)
+			}
+		}
+		sort.Strings(group.Members)
+		groupNames[group.Gid] = group.Name
+		inventory.Groups = append(inventory.Groups, group)
+	}
+
+	shadow := make(map[string][]string)
+	for _, fields := range shadowLines {
+		shadow[fields[0]] = fields
+	}
+
+	parser := &sudoersParser{
+		etcRoot: c.etcRoot,
+		aliases: make(map[string]map[string][]string),
+		visited: make(map[string]bool),
+	}
+	parser.parseFile(filepath.Join(c.etcRoot, "sudoers"), 0)
+
+	for _, fields := range passwd {
+		if len(fields) < 7 {
+			continue
+		}
+		uid, err1 := strconv.ParseUint(fields[2], 10, 32)
+		gid, err2 := strconv.ParseUint(fields[3], 10, 32)
+		if err1 != nil || err2 != nil {
+			continue
+		}
+		account := &proto.LocalAccount{
+			Name:  fields[0],
+			Uid:   uint32(uid),
+			Gid:   uint32(gid),
+			Home:  fields[5],
+			Shell: fields[6],
+		}
+
+		groups := append([]string{}, memberOf[account.Name]...)
+		if name, ok := groupNames[account.Gid]; ok && !containsString(groups, name) {
+			groups = append(groups, name)
+		}
+		sort.Strings(groups)
+		account.Groups = groups
+
+		if fields, ok := shadow[account.Name]; ok {
+			applyShadow(account, fields)
+		}
+
+		account.Root = account.Uid == 0
+		for _, rule := range parser.rules {
+			if rule.appliesTo(account, parser.aliases) {
+				account.Sudo = append(account.Sudo, rule.text)
+				account.Root = account.Root || rule.root
+			}
+		}
+		inventory.Accounts = append(inventory.Accounts, account)
+	}
+
+	sort.Slice(inventory.Accounts, func(i, j int) bool {
+		return inventory.Accounts[i].Name < inventory.Accounts[j].Name
+	})
+	sort.Slice(inventory.Groups, func(i, j int) bool {
+		return inventory.Groups[i].Name < inventory.Groups[j].Name
+	})
+	info.Accounts = inventory
+	return nil
+}
+
+// applyShadow 从shadow记录中读取密码修改时间、有效期和账户过期时间，不读取密码哈希
+func applyShadow(account *proto.LocalAccount, fields []string) {
+	const day = 24 * 60 * 60
+	if len(fields) > 2 {
+		if days, err := strconv.ParseInt(fields[2], 10, 64); err == nil && days > 0 {
+			account.PasswordChanged = days * day
+		}
+	}
+	if len(fields) > 4 {
+		// 99999 是passwd等工具表示"不过期"的惯用值
+		if days, err := strconv.ParseInt(fields[4], 10, 32); err == nil && days > 0 && days < 99999 {
+			account.PasswordMaxDays = int32(days)
+		}
+	}
+	if len(fields) > 7 {
+		if days, err := strconv.ParseInt(fields[7], 10, 64); err == nil && days > 0 {
+			account.Expires = days * day
+		}
+	}
+}
+
+// readColonFile 读取以冒号分隔字段的文件，跳过空行和注释
+func readColonFile(path string) ([][]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var records [][]string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		records = append(records, strings.Split(line, ":"))
+	}
+	return records, scanner.Err()
+}
+
+// sudoRule 是sudoers中的一条用户规则
+type sudoRule struct {
+	users []string // 未展开User_Alias的用户列表，例如 alice、%wheel、#0、ALL、!bob
+	text  string   // 规范化空白后的规则原文
+	root  bool     // 是否允许以root身份执行任意命令
+}
+
+// appliesTo 判断规则是否适用于账户，列表按顺序匹配，以"!"开头的项排除之前匹配的账户
+func (r *sudoRule) appliesTo(account *proto.LocalAccount, aliases map[string]map[string][]string) bool {
+	return matchSudoUsers(r.users, account, aliases["User_Alias"], 0)
+}
+
+func matchSudoUsers(users []string, account *proto.LocalAccount, aliases map[string][]string, depth int) bool {
+	matched := false
+	for _, user := range users {
+		negated := false
+		for strings.HasPrefix(user, "!") {
+			negated = !negated
+			user = user[1:]
+		}
+
+		var ok bool
+		switch {
+		case user == "ALL":
+			ok = true
+		case strings.HasPrefix(user, "%#"):
+			ok = user[2:] == strconv.FormatUint(uint64(account.Gid), 10)
+		case strings.HasPrefix(user, "%:"):
+			ok = containsString(account.Groups, user[2:])
+		case strings.HasPrefix(user, "%"):
+			ok = containsString(account.Groups, user[1:])
+		case strings.HasPrefix(user, "#"):
+			ok = user[1:] == strconv.FormatUint(uint64(account.Uid), 10)
+		case aliases[user] != nil:
+			ok = depth < maxSudoersDepth && matchSudoUsers(aliases[user], account, aliases, depth+1)
+		default:
+			ok = user == account.Name
+		}
+		if ok {
+			matched = !negated
+		}
+	}
+	return matched
+}
+
+// sudoersParser 解析sudoers及其包含的文件
+type sudoersParser struct {
+	etcRoot string
+	aliases map[string]map[string][]string // 别名类型 -> 名称 -> 成员
+	rules   []*sudoRule
+	visited map[string]bool
+}
+
+// resolve 将sudoers中引用的/etc下的路径映射到etcRoot下
+func (p *sudoersParser) resolve(path string) string {
+	if rest, ok := strings.CutPrefix(path, "/etc/"); ok && p.etcRoot != DefaultEtcRoot {
+		return filepath.Join(p.etcRoot, rest)
+	}
+	return path
+}
+
+// parseFile 解析一个sudoers文件，文件不存在或不可读时忽略
+func (p *sudoersParser) parseFile(path string, depth int) {
+	if depth > maxSudoersDepth || p.visited[path] {
+		return
+	}
+	p.visited[path] = true
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return
+	}
+
+	// 以反斜杠结尾的行与下一行合并
+	text := strings.ReplaceAll(string(data), "\\\n", " ")
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		directive, arg, _ := strings.Cut(line, " ")
+		arg = strings.TrimSpace(arg)
+		switch directive {
+		case "@include", "#include":
+			if !filepath.IsAbs(arg) {
+				arg = filepath.Join(filepath.Dir(path), arg)
+			}
+			p.parseFile(p.resolve(arg), depth+1)
+			continue
+		case "@includedir", "#includedir":
+			p.parseDir(p.resolve(arg), depth+1)
+			continue
+		}
+
+		// "#"后紧跟数字时是以UID指定的用户，例如 "#1001 ALL=(ALL) ALL"，否则是注释
+		if (strings.HasPrefix(line, "#") && !uidUserPattern.MatchString(line)) || strings.HasPrefix(line, "Defaults") {
+			continue
+		}
+		switch directive {
+		case "User_Alias", "Runas_Alias", "Host_Alias", "Cmnd_Alias", "Cmd_Alias":
+			p.parseAliases(directive, arg)
+			continue
+		}
+		p.parseUserSpec(line)
+	}
+}
+
+// parseDir 按文件名顺序解析目录中的文件，与sudo一样跳过以"~"结尾或包含"."的文件
+func (p *sudoersParser) parseDir(dir string, depth int) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || strings.HasSuffix(name, "~") || strings.Contains(name, ".") {
+			continue
+		}
+		p.parseFile(filepath.Join(dir, name), depth)
+	}
+}
+
+// parseAliases 解析别名定义，例如 "ADMINS = alice, bob : OPS = carol"
+func (p *sudoersParser) parseAliases(kind string, definition string) {
+	if kind == "Cmd_Alias" {
+		kind = "Cmnd_Alias"
+	}
+	if p.aliases[kind] == nil {
+		p.aliases[kind] = make(map[string][]string)
+	}
+	for _, part := range strings.Split(definition, ":") {
+		name, members, ok := strings.Cut(part, "=")
+		if !ok {
+			continue
+		}
+		p.aliases[kind][strings.TrimSpace(name)] = splitSudoList(members)
+	}
+}
+
+// parseUserSpec 解析用户规则，例如 "%admin ALL=(ALL:ALL) NOPASSWD: ALL"
+func (p *sudoersParser) parseUserSpec(line string) {
+	left, right, ok := strings.Cut(line, "=")
+	if !ok {
+		return
+	}
+	fields := strings.Fields(listSeparator.ReplaceAllString(strings.TrimSpace(left), ","))
+	if len(fields) != 2 {
+		return
+	}
+
+	rule := &sudoRule{
+		users: splitSudoList(fields[0]),
+		text:  strings.Join(strings.Fields(line), " "),
+	}
+
+	// 命令列表中的每一项可以指定自己的身份和标签，未指定时沿用前一项
+	runas := ""
+	hasRunas := false
+	for _, item := range splitTopLevel(right) {
+		item = strings.TrimSpace(item)
+		if strings.HasPrefix(item, "(") {
+			if end := strings.Index(item, ")"); end > 0 {
+				runas = item[1:end]
+				hasRunas = true
+				item = strings.TrimSpace(item[end+1:])
+			}
+		}
+		for {
+			if m := sudoTagPattern.FindString(item); m != "" {
+				item = item[len(m):]
+			} else if m := sudoOptionPattern.FindString(item); m != "" {
+				item = item[len(m):]
+			} else {
+				break
+			}
+		}
+
+		if p.allowsAnyCommand(item, 0) && p.runsAsRoot(runas, hasRunas) {
+			rule.root = true
+		}
+	}
+	p.rules = append(p.rules, rule)
+}
+
+// allowsAnyCommand 判断命令（或命令别名）是否为ALL
+func (p *sudoersParser) allowsAnyCommand(command string, depth int) bool {
+	if command == "ALL" {
+		return true
+	}
+	if depth >= maxSudoersDepth {
+		return false
+	}
+	for _, member := range p.aliases["Cmnd_Alias"][command] {
+		if p.allowsAnyCommand(member, depth+1) {
+			return true
+		}
+	}
+	return false
+}
+
+// runsAsRoot 判断身份说明是否允许以root执行；未指定身份时默认为root，只指定组时以调用者自己的身份执行
+func (p *sudoersParser) runsAsRoot(runas string, specified bool) bool {
+	if !specified {
+		return true
+	}
+	users, _, _ := strings.Cut(runas, ":")
+	return p.includesRoot(splitSudoList(users), 0)
+}
+
+func (p *sudoersParser) includesRoot(users []string, depth int) bool {
+	for _, user := range users {
+		switch {
+		case user == "ALL" || user == "root" || user == "#0":
+			return true
+		case depth < maxSudoersDepth && p.aliases["Runas_Alias"][user] != nil:
+			if p.includesRoot(p.aliases["Runas_Alias"][user], depth+1) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// splitSudoList 拆分逗号分隔的列表
+func splitSudoList(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// splitTopLevel 按不在括号内的逗号拆分命令列表
+func splitTopLevel(s string) []string {
+	var items []string
+	depth, start := 0, 0
+	for i, r := range s {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				items = append(items, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(items, s[start:])
+}
@@ -0,0 +1,138 @@
+package collectors
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"GoMonitor/proto"
+)
+
+func TestAccountCollector(t *testing.T) {
+	root := t.TempDir()
+	writeFixture(t, root, map[string]string{
+		"passwd": `root:x:0:0:root:/root:/bin/bash
+# 注释行
+alice:x:1000:1000::/home/alice:/bin/bash
+bob:x:1001:1001::/home/bob:/bin/zsh
+carol:x:1002:1002::/home/carol:/bin/bash
+dave:x:1003:1003::/home/dave:/bin/bash
+erin:x:1004:100::/home/erin:/bin/sh
+frank:x:1005:1005::/home/frank:/bin/bash
+broken:x:notanumber:0::/:/bin/false
+`,
+		"group": `root:x:0:
+users:x:100:
+wheel:x:10:alice,bob
+alice:x:1000:
+bob:x:1001:
+ops:x:2000:carol,dave
+`,
+		"shadow": `root:!:19000:0:99999:7:::
+alice:$6$hash:19500:0:90:7::20000:
+`,
+		"sudoers": `Defaults env_reset
+# 注释中的 ALL=(ALL) ALL 不是规则
+User_Alias ADMINS = frank, \
+    %ops, !dave
+Runas_Alias OPERATORS = root, operator
+Cmnd_Alias SHELLS = /bin/bash, /bin/sh
+Cmnd_Alias EVERYTHING = ALL
+root ALL=(ALL:ALL) ALL
+%wheel ALL=(ALL) NOPASSWD: ALL
+ADMINS ALL = (OPERATORS) SETENV: EVERYTHING
+%#100 ALL=(www-data) ALL
+@includedir /etc/sudoers.d
+`,
+		"sudoers.d/restricted": `#1001 ALL=(root) /usr/bin/systemctl restart nginx, (:adm) ALL
+dave ALL=(ALL) SHELLS
+`,
+		"sudoers.d/ignored.bak": "erin ALL=(ALL) ALL\n",
+		"sudoers.d/editor~":     "erin ALL=(ALL) ALL\n",
+	})
+
+	info := &proto.SystemInfo{}
+	if err := NewAccountCollector(root).Collect(context.Background(), Options{}, info); err != nil {
+		t.Fatal(err)
+	}
+
+	accounts := make(map[string]*proto.LocalAccount)
+	for _, account := range info.Accounts.GetAccounts() {
+		accounts[account.Name] = account
+	}
+	if len(accounts) != 7 {
+		t.Fatalf("得到 %d 个账户，期望 7 个", len(accounts))
+	}
+
+	tests := []struct {
+		name   string
+		groups string
+		root   bool
+		sudo   int
+	}{
+		{"root", "root", true, 1},
+		// wheel组成员，可以无密码执行任意命令
+		{"alice", "alice,wheel", true, 1},
+		// #1001的规则只允许指定命令，或只切换组；wheel规则使其成为root
+		{"bob", "bob,wheel", true, 2},
+		// 通过User_Alias中的%ops匹配，Runas_Alias包含root且命令别名为ALL
+		{"carol", "ops", true, 1},
+		// 被User_Alias中的!dave排除；SHELLS不是任意命令
+		{"dave", "ops", false, 1},
+		// %#100按主组GID匹配，但只能以www-data执行；被跳过的文件中的规则不生效
+		{"erin", "users", false, 1},
+		{"frank", "", true, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			account := accounts[tt.name]
+			if account == nil {
+				t.Fatal("账户不存在")
+			}
+			if got := strings.Join(account.Groups, ","); got != tt.groups {
+				t.Errorf("组 = %s, 期望 %s", got, tt.groups)
+			}
+			if account.Root != tt.root {
+				t.Errorf("root = %v, 期望 %v (规则: %v)", account.Root, tt.root, account.Sudo)
+			}
+			if len(account.Sudo) != tt.sudo {
+				t.Errorf("sudo规则 = %v, 期望 %d 条", account.Sudo, tt.sudo)
+			}
+		})
+	}
+
+	alice := accounts["alice"]
+	if alice.PasswordChanged != 19500*86400 || alice.PasswordMaxDays != 90 || alice.Expires != 20000*86400 {
+		t.Errorf("alice的密码信息 = %d, %d, %d", alice.PasswordChanged, alice.PasswordMaxDays, alice.Expires)
+	}
+	if accounts["root"].PasswordMaxDays != 0 {
+		t.Errorf("99999天应视为不过期")
+	}
+	if got := accounts["alice"].Sudo[0]; got != "%wheel ALL=(ALL) NOPASSWD: ALL" {
+		t.Errorf("规则原文 = %q", got)
+	}
+}
+
+func TestAccountCollectorWithoutPasswd(t *testing.T) {
+	info := &proto.SystemInfo{}
+	if err := NewAccountCollector(filepath.Join(t.TempDir(), "missing")).Collect(context.Background(), Options{}, info); err != nil {
+		t.Fatal(err)
+	}
+	if info.Accounts != nil {
+		t.Fatalf("没有passwd时不应上报: %v", info.Accounts)
+	}
+}
+
+func TestSplitTopLevel(t *testing.T) {
+	got := splitTopLevel("(root) /bin/ls, (app, web) /bin/cat,ALL")
+	want := []string{"(root) /bin/ls", " (app, web) /bin/cat", "ALL"}
+	if len(got) != len(want) {
+		t.Fatalf("splitTopLevel = %q", got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("splitTopLevel = %q, 期望 %q", got, want)
+		}
+	}
+}
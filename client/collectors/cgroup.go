@@ -0,0 +1,361 @@
+package collectors
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"GoMonitor/proto"
+)
+
+// DefaultCgroupRoot 是cgroup文件系统的默认挂载点
+const DefaultCgroupRoot = "/sys/fs/cgroup"
+
+// cgroup计数器在rateTracker中的下标
+const (
+	cgroupCPUUsageNs = iota
+	cgroupThrottled
+	cgroupIORead
+	cgroupIOWrite
+)
+
+// containerIDPattern 匹配cgroup目录名中的64位容器ID，
+// 例如 docker-<id>.scope、cri-containerd-<id>.scope、libpod-<id>.scope 或 cgroupfs驱动下的 <id>
+var containerIDPattern = regexp.MustCompile(`(?:^|[-_/])([0-9a-f]{64})(?:\.scope)?$`)
+
+// cgroupStats 是从cgroup文件中读取的原始数据
+type cgroupStats struct {
+	cpuUsageNs    uint64
+	throttled     uint64
+	cpuLimitCores float64
+	memoryUsage   uint64
+	memoryLimit   uint64
+	ioRead        uint64
+	ioWrite       uint64
+	pidsCurrent   uint64
+	pidsLimit     uint64
+}
+
+// CgroupCollector 遍历cgroup层级，上报各容器和顶层systemd slice的CPU、内存、IO和进程数
+// 同时支持cgroup v2（统一层级）和v1（按控制器分别挂载）
+type CgroupCollector struct {
+	root   string
+	docker *DockerClient
+	rates  *rateTracker
+}
+
+// NewCgroupCollector 创建cgroup采集器
+// root为空时使用DefaultCgroupRoot；dockerSocket为空时不查询容器名称
+func NewCgroupCollector(root string, dockerSocket string) *CgroupCollector {
+	if root == "" {
+		root = DefaultCgroupRoot
+	}
+
+	c := &CgroupCollector{
+		root:  root,
+		rates: newRateTracker(),
+	}
+	if dockerSocket != "" {
+		c.docker = NewDockerClient(dockerSocket)
+	}
+	return c
+}
+
+// Name 返回采集器名称
+func (c *CgroupCollector) Name() string {
+	return "cgroups"
+}
+
+// Collect 实现Collector接口
+// 没有挂载cgroup的系统不上报；无法访问Docker时仍上报资源数据，只是缺少容器名称
+func (c *CgroupCollector) Collect(ctx context.Context, opts Options, info *proto.SystemInfo) error {
+	if _, err := os.Stat(c.root); os.IsNotExist(err) {
+		return nil
+	}
+
+	v2 := c.isV2()
+	groups, err := c.discover(v2)
+	if err != nil {
+		return err
+	}
+
+	stats := make(map[string]*cgroupStats, len(groups))
+	counters := make(map[string][]uint64, len(groups))
+	for _, group := range groups {
+		var s *cgroupStats
+		if v2 {
+			s = c.readV2(group)
+		} else {
+			s = c.readV1(group)
+		}
+		stats[group] = s
+		counters[group] = []uint64{
+			cgroupCPUUsageNs: s.cpuUsageNs,
+			cgroupThrottled:  s.throttled,
+			cgroupIORead:     s.ioRead,
+			cgroupIOWrite:    s.ioWrite,
+		}
+	}
+
+	rates, elapsed := c.rates.Update(ctx, counters, !opts.Peek)
+
+	var names map[string]string
+	if c.docker != nil && c.docker.Available() {
+		names, err = c.docker.ContainerNames(ctx)
+		if err != nil {
+			info.CollectorErrors = append(info.CollectorErrors, &proto.CollectorError{
+				Collector: "cgroups:docker",
+				Error:     err.Error(),
+				Timestamp: time.Now().Unix(),
+			})
+		}
+	}
+
+	for _, group := range groups {
+		s := stats[group]
+		container := &proto.ContainerStats{
+			Cgroup:        group,
+			Runtime:       cgroupRuntime(group),
+			CpuLimitCores: s.cpuLimitCores,
+			MemoryUsage:   int64(s.memoryUsage),
+			MemoryLimit:   int64(s.memoryLimit),
+			PidsCurrent:   int64(s.pidsCurrent),
+			PidsLimit:     int64(s.pidsLimit),
+		}
+
+		if match := containerIDPattern.FindStringSubmatch(filepath.Base(group)); match != nil {
+			container.Id = match[1]
+			container.Name = names[container.Id]
+		}
+
+		if r, ok := rates[group]; ok {
+			// cpu使用时间单位为纳秒，每秒1e9纳秒即占满一个核心
+			container.CpuUsagePercent = r.perSecond(cgroupCPUUsageNs) / 1e9 * 100
+			container.CpuThrottledPeriods = int64(r.deltas[cgroupThrottled])
+			container.IoReadBytesPerSec = r.perSecond(cgroupIORead)
+			container.IoWriteBytesPerSec = r.perSecond(cgroupIOWrite)
+			container.SampleSeconds = elapsed.Seconds()
+		}
+
+		info.Containers = append(info.Containers, container)
+	}
+
+	return nil
+}
+
+// isV2 判断是否为cgroup v2统一层级
+func (c *CgroupCollector) isV2() bool {
+	_, err := os.Stat(filepath.Join(c.root, "cgroup.controllers"))
+	return err == nil
+}
+
+// discover 查找需要上报的cgroup：顶层的 *.slice 以及任意深度的容器，返回相对路径
+// v1下以memory控制器的层级为准
+func (c *CgroupCollector) discover(v2 bool) ([]string, error) {
+	base := c.root
+	if !v2 {
+		base = filepath.Join(c.root, "memory")
+	}
+
+	var groups []string
+	err := filepath.WalkDir(base, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// 容器退出时目录可能在遍历过程中被删除
+			if path != base && os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if !d.IsDir() || path == base {
+			return nil
+		}
+
+		rel, err := filepath.Rel(base, path)
+		if err != nil {
+			return err
+		}
+
+		if containerIDPattern.MatchString(d.Name()) {
+			groups = append(groups, rel)
+			return filepath.SkipDir
+		}
+		if !strings.Contains(rel, string(filepath.Separator)) && strings.HasSuffix(d.Name(), ".slice") {
+			groups = append(groups, rel)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("遍历cgroup失败: %w", err)
+	}
+
+	sort.Strings(groups)
+	return groups, nil
+}
+
+// readV2 读取cgroup v2统一层级中的数据，缺失的文件（未启用的控制器）对应的值为0
+func (c *CgroupCollector) readV2(group string) *cgroupStats {
+	dir := filepath.Join(c.root, group)
+	s := &cgroupStats{}
+
+	cpuStat := readKeyValueFile(filepath.Join(dir, "cpu.stat"))
+	s.cpuUsageNs = cpuStat["usage_usec"] * 1000
+	s.throttled = cpuStat["nr_throttled"]
+
+	// cpu.max 格式为 "$MAX $PERIOD"，MAX为max表示不限制
+	if fields := strings.Fields(readStringFile(filepath.Join(dir, "cpu.max"))); len(fields) == 2 {
+		quota, errQuota := strconv.ParseFloat(fields[0], 64)
+		period, errPeriod := strconv.ParseFloat(fields[1], 64)
+		if errQuota == nil && errPeriod == nil && period > 0 {
+			s.cpuLimitCores = quota / period
+		}
+	}
+
+	s.memoryUsage = readLimitFile(filepath.Join(dir, "memory.current"))
+	s.memoryLimit = readLimitFile(filepath.Join(dir, "memory.max"))
+	s.pidsCurrent = readLimitFile(filepath.Join(dir, "pids.current"))
+	s.pidsLimit = readLimitFile(filepath.Join(dir, "pids.max"))
+
+	// io.stat 每行一个设备：8:0 rbytes=1 wbytes=2 rios=3 wios=4 ...
+	for _, line := range readLines(filepath.Join(dir, "io.stat")) {
+		for _, field := range strings.Fields(line)[1:] {
+			key, value, _ := strings.Cut(field, "=")
+			n, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				continue
+			}
+			switch key {
+			case "rbytes":
+				s.ioRead += n
+			case "wbytes":
+				s.ioWrite += n
+			}
+		}
+	}
+
+	return s
+}
+
+// readV1 从cgroup v1各控制器的层级中读取同一路径下的数据
+func (c *CgroupCollector) readV1(group string) *cgroupStats {
+	controller := func(name, file string) string {
+		return filepath.Join(c.root, name, group, file)
+	}
+	s := &cgroupStats{}
+
+	s.cpuUsageNs = readLimitFile(controller("cpuacct", "cpuacct.usage"))
+	s.throttled = readKeyValueFile(controller("cpu", "cpu.stat"))["nr_throttled"]
+
+	quota, errQuota := strconv.ParseFloat(readStringFile(controller("cpu", "cpu.cfs_quota_us")), 64)
+	period, errPeriod := strconv.ParseFloat(readStringFile(controller("cpu", "cpu.cfs_period_us")), 64)
+	if errQuota == nil && errPeriod == nil && quota > 0 && period > 0 {
+		s.cpuLimitCores = quota / period
+	}
+
+	s.memoryUsage = readLimitFile(controller("memory", "memory.usage_in_bytes"))
+	s.memoryLimit = readLimitFile(controller("memory", "memory.limit_in_bytes"))
+	// v1没有设置内存限制时返回接近int64上限的值
+	if s.memoryLimit >= math.MaxInt64/2 {
+		s.memoryLimit = 0
+	}
+
+	s.pidsCurrent = readLimitFile(controller("pids", "pids.current"))
+	s.pidsLimit = readLimitFile(controller("pids", "pids.max"))
+
+	// blkio.throttle.io_service_bytes 每行为 "8:0 Read 123"，最后一行为 "Total 456"
+	for _, line := range readLines(controller("blkio", "blkio.throttle.io_service_bytes")) {
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			continue
+		}
+		n, err := strconv.ParseUint(fields[2], 10, 64)
+		if err != nil {
+			continue
+		}
+		switch fields[1] {
+		case "Read":
+			s.ioRead += n
+		case "Write":
+			s.ioWrite += n
+		}
+	}
+
+	return s
+}
+
+// cgroupRuntime 根据cgroup路径推断容器运行时
+func cgroupRuntime(group string) string {
+	base := filepath.Base(group)
+	switch {
+	case strings.Contains(group, "docker"):
+		return "docker"
+	case strings.HasPrefix(base, "cri-containerd-"), strings.Contains(group, "containerd"):
+		return "containerd"
+	case strings.HasPrefix(base, "libpod-"):
+		return "podman"
+	case strings.HasPrefix(base, "crio-"):
+		return "crio"
+	case strings.HasSuffix(base, ".slice"):
+		return "systemd"
+	}
+	return ""
+}
+
+// readStringFile 读取单行文件，失败时返回空字符串
+func readStringFile(path string) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
+
+// readLimitFile 读取单个数值，"max"或读取失败时返回0
+func readLimitFile(path string) uint64 {
+	n, err := strconv.ParseUint(readStringFile(path), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+// readKeyValueFile 读取每行 "key value" 形式的文件，例如cpu.stat
+func readKeyValueFile(path string) map[string]uint64 {
+	values := make(map[string]uint64)
+	for _, line := range readLines(path) {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		if n, err := strconv.ParseUint(fields[1], 10, 64); err == nil {
+			values[fields[0]] = n
+		}
+	}
+	return values
+}
+
+// readLines 读取文件的所有非空行，失败时返回nil
+func readLines(path string) []string {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer file.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
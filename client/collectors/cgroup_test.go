@@ -0,0 +1,154 @@
+package collectors
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"GoMonitor/proto"
+)
+
+const testContainerID = "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+func TestCgroupCollector(t *testing.T) {
+	dockerScope := "system.slice/docker-" + testContainerID + ".scope"
+	tests := []struct {
+		name  string
+		files map[string]string
+		want  []*proto.ContainerStats
+	}{
+		{
+			name: "v2",
+			files: map[string]string{
+				"cgroup.controllers":                  "cpu io memory pids",
+				"system.slice/memory.current":         "1048576",
+				"system.slice/memory.max":             "max",
+				"user.slice/pids.current":             "12",
+				"user.slice/user-1000.slice/pids.max": "100",
+				dockerScope + "/cpu.max":              "150000 100000",
+				dockerScope + "/cpu.stat":             "usage_usec 5000\nnr_throttled 3\n",
+				dockerScope + "/memory.current":       "2048",
+				dockerScope + "/memory.max":           "4096",
+				dockerScope + "/pids.current":         "4",
+				dockerScope + "/pids.max":             "max",
+				dockerScope + "/io.stat":              "8:0 rbytes=100 wbytes=200 rios=1 wios=2\n8:16 rbytes=1 wbytes=2\n",
+				dockerScope + "/child/memory.current": "1",
+				"init.scope/memory.current":           "1",
+			},
+			want: []*proto.ContainerStats{
+				{Cgroup: "system.slice", Runtime: "systemd", MemoryUsage: 1048576},
+				{Cgroup: dockerScope, Id: testContainerID, Runtime: "docker", CpuLimitCores: 1.5, MemoryUsage: 2048, MemoryLimit: 4096, PidsCurrent: 4},
+				{Cgroup: "user.slice", Runtime: "systemd", PidsCurrent: 12},
+			},
+		},
+		{
+			name: "v1",
+			files: map[string]string{
+				"memory/docker/" + testContainerID + "/memory.usage_in_bytes":          "8192",
+				"memory/docker/" + testContainerID + "/memory.limit_in_bytes":          "9223372036854771712",
+				"cpu/docker/" + testContainerID + "/cpu.cfs_quota_us":                  "50000",
+				"cpu/docker/" + testContainerID + "/cpu.cfs_period_us":                 "100000",
+				"cpu/docker/" + testContainerID + "/cpu.stat":                          "nr_periods 10\nnr_throttled 2\n",
+				"pids/docker/" + testContainerID + "/pids.current":                     "7",
+				"pids/docker/" + testContainerID + "/pids.max":                         "64",
+				"blkio/docker/" + testContainerID + "/blkio.throttle.io_service_bytes": "8:0 Read 10\n8:0 Write 20\nTotal 30\n",
+				"memory/system.slice/memory.usage_in_bytes":                            "4096",
+				"memory/system.slice/memory.limit_in_bytes":                            "1073741824",
+				"cpu/system.slice/cpu.cfs_quota_us":                                    "-1",
+				"cpu/system.slice/cpu.cfs_period_us":                                   "100000",
+			},
+			want: []*proto.ContainerStats{
+				{Cgroup: "docker/" + testContainerID, Id: testContainerID, Runtime: "docker", CpuLimitCores: 0.5, MemoryUsage: 8192, PidsCurrent: 7, PidsLimit: 64},
+				{Cgroup: "system.slice", Runtime: "systemd", MemoryUsage: 4096, MemoryLimit: 1073741824},
+			},
+		},
+		{
+			name:  "未挂载",
+			files: map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			writeFixture(t, root, tt.files)
+			if len(tt.files) == 0 {
+				root += "/missing"
+			}
+
+			info := &proto.SystemInfo{}
+			if err := NewCgroupCollector(root, "").Collect(context.Background(), Options{}, info); err != nil {
+				t.Fatal(err)
+			}
+			if len(info.Containers) != len(tt.want) {
+				t.Fatalf("得到 %d 个cgroup，期望 %d 个: %v", len(info.Containers), len(tt.want), info.Containers)
+			}
+			for i, want := range tt.want {
+				got := info.Containers[i]
+				if got.Cgroup != want.Cgroup || got.Id != want.Id || got.Runtime != want.Runtime ||
+					got.CpuLimitCores != want.CpuLimitCores || got.MemoryUsage != want.MemoryUsage ||
+					got.MemoryLimit != want.MemoryLimit || got.PidsCurrent != want.PidsCurrent || got.PidsLimit != want.PidsLimit {
+					t.Errorf("第 %d 个cgroup = %v, 期望 %v", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestCgroupCollectorRates(t *testing.T) {
+	root := t.TempDir()
+	group := "system.slice/docker-" + testContainerID + ".scope"
+	writeFixture(t, root, map[string]string{
+		"cgroup.controllers": "cpu io",
+		group + "/cpu.stat":  "usage_usec 1000000\nnr_throttled 1\n",
+		group + "/io.stat":   "8:0 rbytes=0 wbytes=0\n",
+	})
+
+	c := NewCgroupCollector(root, "")
+	if err := c.Collect(context.Background(), Options{}, &proto.SystemInfo{}); err != nil {
+		t.Fatal(err)
+	}
+	writeFixture(t, root, map[string]string{
+		group + "/cpu.stat": "usage_usec 1500000\nnr_throttled 4\n",
+		group + "/io.stat":  "8:0 rbytes=4096 wbytes=0\n",
+	})
+	info := &proto.SystemInfo{}
+	if err := c.Collect(context.Background(), Options{}, info); err != nil {
+		t.Fatal(err)
+	}
+
+	var container *proto.ContainerStats
+	for _, stats := range info.Containers {
+		if stats.Cgroup == group {
+			container = stats
+		}
+	}
+	if container == nil || container.SampleSeconds <= 0 {
+		t.Fatalf("没有速率: %v", info.Containers)
+	}
+	if container.CpuThrottledPeriods != 3 || container.CpuUsagePercent <= 0 || container.IoReadBytesPerSec <= 0 {
+		t.Fatalf("速率 = %v", container)
+	}
+}
+
+func TestCgroupCollectorDockerUnavailable(t *testing.T) {
+	root := t.TempDir()
+	writeFixture(t, root, map[string]string{
+		"cgroup.controllers": "memory",
+		"system.slice/docker-" + testContainerID + ".scope/memory.current": "1",
+		// 普通文件冒充socket，连接失败
+		"docker.sock": "",
+	})
+
+	info := &proto.SystemInfo{}
+	if err := NewCgroupCollector(root, root+"/docker.sock").Collect(context.Background(), Options{}, info); err != nil {
+		t.Fatal(err)
+	}
+	if len(info.Containers) != 2 {
+		t.Fatalf("得到 %d 个cgroup: %v", len(info.Containers), info.Containers)
+	}
+	if len(info.CollectorErrors) != 1 || info.CollectorErrors[0].Collector != "cgroups:docker" ||
+		!strings.Contains(info.CollectorErrors[0].Error, "查询Docker容器失败") {
+		t.Fatalf("采集器错误 = %v", info.CollectorErrors)
+	}
+}
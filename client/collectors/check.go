@@ -0,0 +1,213 @@
+package collectors
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"GoMonitor/proto"
+)
+
+// CheckSpec 描述一个Nagios兼容的检查插件
+type CheckSpec struct {
+	Name     string
+	Command  string
+	Args     []string
+	Interval time.Duration
+	Timeout  time.Duration
+}
+
+// CheckCollector 按各自的间隔在后台运行检查插件，并上报每个检查的最新结果
+// 退出码 0/1/2/3 分别对应 OK/WARNING/CRITICAL/UNKNOWN，其他退出码、超时或无法执行均视为UNKNOWN
+type CheckCollector struct {
+	mu      sync.Mutex
+	specs   []CheckSpec
+	results map[string]*proto.CheckResult
+	runner  *periodicRunner
+}
+
+// NewCheckCollector 创建检查采集器
+func NewCheckCollector() *CheckCollector {
+	return &CheckCollector{
+		results: make(map[string]*proto.CheckResult),
+	}
+}
+
+// Name 返回采集器名称
+func (c *CheckCollector) Name() string {
+	return "checks"
+}
+
+// SetChecks 替换检查列表并重新启动后台任务
+func (c *CheckCollector) SetChecks(specs []CheckSpec) {
+	c.mu.Lock()
+	old := c.runner
+	c.runner = nil
+	c.mu.Unlock()
+
+	old.stop()
+
+	runner, ctx := newPeriodicRunner()
+
+	c.mu.Lock()
+	c.specs = specs
+	c.results = make(map[string]*proto.CheckResult)
+	c.runner = runner
+	c.mu.Unlock()
+
+	for _, spec := range specs {
+		spec := spec
+		runner.startPeriodic(ctx, spec.Interval, func(ctx context.Context) {
+			result := RunCheck(ctx, spec)
+			if ctx.Err() != nil {
+				return
+			}
+
+			c.mu.Lock()
+			c.results[spec.Name] = result
+			c.mu.Unlock()
+		})
+	}
+}
+
+// Stop 停止所有检查任务
+func (c *CheckCollector) Stop() {
+	c.mu.Lock()
+	runner := c.runner
+	c.runner = nil
+	c.mu.Unlock()
+
+	runner.stop()
+}
+
+// Collect 实现Collector接口，按配置顺序返回各检查最近一次的结果
+func (c *CheckCollector) Collect(ctx context.Context, opts Options, info *proto.SystemInfo) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, spec := range c.specs {
+		if result, ok := c.results[spec.Name]; ok {
+			info.CheckResults = append(info.CheckResults, result)
+		}
+	}
+	return nil
+}
+
+// RunCheck 运行一次检查插件并解析结果
+func RunCheck(ctx context.Context, spec CheckSpec) *proto.CheckResult {
+	start := time.Now()
+	result := &proto.CheckResult{
+		Name:       spec.Name,
+		ExecutedAt: start.Unix(),
+	}
+
+	execResult, err := runExternal(ctx, spec.Timeout, spec.Command, spec.Args)
+	result.DurationMs = time.Since(start).Milliseconds()
+
+	if err != nil {
+		result.State = proto.CheckState_CHECK_UNKNOWN
+		result.Output = fmt.Sprintf("检查执行失败: %v", err)
+		return result
+	}
+
+	switch execResult.ExitCode {
+	case 0, 1, 2, 3:
+		result.State = proto.CheckState(execResult.ExitCode)
+	default:
+		result.State = proto.CheckState_CHECK_UNKNOWN
+	}
+
+	output := string(execResult.Stdout)
+	if strings.TrimSpace(output) == "" {
+		output = execResult.Stderr
+	}
+	result.Output, result.LongOutput, result.PerfData = ParseCheckOutput(output)
+
+	if execResult.ExitCode > 3 {
+		result.Output = fmt.Sprintf("未知的退出码 %d: %s", execResult.ExitCode, result.Output)
+	}
+	return result
+}
+
+// ParseCheckOutput 按Nagios插件输出规范解析文本
+// 第一行 "|" 之前为简要输出，之后为性能数据；其余行为详细输出，其中第一个 "|" 之后的内容也是性能数据
+func ParseCheckOutput(output string) (string, string, []*proto.PerfData) {
+	lines := strings.Split(strings.TrimRight(output, "\n"), "\n")
+
+	var perfParts []string
+
+	first, perf, _ := strings.Cut(lines[0], "|")
+	perfParts = append(perfParts, perf)
+
+	var longLines []string
+	inPerf := false
+	for _, line := range lines[1:] {
+		if inPerf {
+			perfParts = append(perfParts, line)
+			continue
+		}
+
+		text, perf, found := strings.Cut(line, "|")
+		longLines = append(longLines, text)
+		if found {
+			perfParts = append(perfParts, perf)
+			inPerf = true
+		}
+	}
+
+	return strings.TrimSpace(first),
+		strings.TrimSpace(strings.Join(longLines, "\n")),
+		ParsePerfData(strings.Join(perfParts, " "))
+}
+
+// perfDataPattern 匹配 'label'=value[UOM];[warn];[crit];[min];[max]，标签可以用单引号包含空格
+var perfDataPattern = regexp.MustCompile(`('(?:[^']|'')+'|[^\s=']+)=([-+]?[\d.]+(?:[eE][-+]?\d+)?)([^;\s]*)((?:;[^;\s]*){0,4})`)
+
+// ParsePerfData 解析性能数据，无法识别的项会被忽略
+func ParsePerfData(raw string) []*proto.PerfData {
+	var perfData []*proto.PerfData
+
+	for _, match := range perfDataPattern.FindAllStringSubmatch(raw, -1) {
+		value, err := strconv.ParseFloat(match[2], 64)
+		if err != nil {
+			continue
+		}
+
+		label := match[1]
+		if strings.HasPrefix(label, "'") {
+			label = strings.ReplaceAll(label[1:len(label)-1], "''", "'")
+		}
+
+		pd := &proto.PerfData{
+			Label: label,
+			Value: value,
+			Unit:  match[3],
+		}
+
+		thresholds := strings.Split(strings.TrimPrefix(match[4], ";"), ";")
+		for i, threshold := range thresholds {
+			switch i {
+			case 0:
+				pd.Warn = threshold
+			case 1:
+				pd.Crit = threshold
+			case 2:
+				if v, err := strconv.ParseFloat(threshold, 64); err == nil {
+					pd.Min = &v
+				}
+			case 3:
+				if v, err := strconv.ParseFloat(threshold, 64); err == nil {
+					pd.Max = &v
+				}
+			}
+		}
+
+		perfData = append(perfData, pd)
+	}
+
+	return perfData
+}
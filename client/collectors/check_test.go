@@ -0,0 +1,98 @@
+package collectors
+
+import (
+	"testing"
+
+	"GoMonitor/proto"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+func float(v float64) *float64 {
+	return &v
+}
+
+func TestParsePerfData(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want []*proto.PerfData
+	}{
+		{"空", "", nil},
+		{
+			"完整阈值",
+			"/=2643MB;5948;5958;0;5968",
+			[]*proto.PerfData{{Label: "/", Value: 2643, Unit: "MB", Warn: "5948", Crit: "5958", Min: float(0), Max: float(5968)}},
+		},
+		{
+			"多项和范围阈值",
+			"time=0.002s;;;0.000 size=1024B load1=0.5;@1:5;~:10",
+			[]*proto.PerfData{
+				{Label: "time", Value: 0.002, Unit: "s", Min: float(0)},
+				{Label: "size", Value: 1024, Unit: "B"},
+				{Label: "load1", Value: 0.5, Warn: "@1:5", Crit: "~:10"},
+			},
+		},
+		{
+			"带引号的标签",
+			"'disk usage'=80%;90;95 'it''s'=1",
+			[]*proto.PerfData{
+				{Label: "disk usage", Value: 80, Unit: "%", Warn: "90", Crit: "95"},
+				{Label: "it's", Value: 1},
+			},
+		},
+		{"科学计数法和负数", "temp=-1.5e1C", []*proto.PerfData{{Label: "temp", Value: -15, Unit: "C"}}},
+		{"无法识别的项被忽略", "garbage rta=U;1;2 pl=0%", []*proto.PerfData{{Label: "pl", Value: 0, Unit: "%"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParsePerfData(tt.raw)
+			if len(got) != len(tt.want) {
+				t.Fatalf("ParsePerfData(%q) = %v, 期望 %v", tt.raw, got, tt.want)
+			}
+			for i := range got {
+				if !protobuf.Equal(got[i], tt.want[i]) {
+					t.Errorf("第 %d 项 = %v, 期望 %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestParseCheckOutput(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+		short  string
+		long   string
+		labels []string
+	}{
+		{"只有简要输出", "DISK OK\n", "DISK OK", "", nil},
+		{"单行性能数据", "PING OK - rta 1ms | rta=1ms;100;500 pl=0%", "PING OK - rta 1ms", "", []string{"rta", "pl"}},
+		{
+			"多行输出",
+			"DISK WARNING | /=90%\n/ 90%\n/home 10% | /home=10%\n/var=50%\n",
+			"DISK WARNING",
+			"/ 90%\n/home 10%",
+			[]string{"/", "/home", "/var"},
+		},
+		{"空输出", "", "", "", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			short, long, perf := ParseCheckOutput(tt.output)
+			if short != tt.short || long != tt.long {
+				t.Fatalf("输出 = (%q, %q), 期望 (%q, %q)", short, long, tt.short, tt.long)
+			}
+			if len(perf) != len(tt.labels) {
+				t.Fatalf("性能数据 = %v, 期望标签 %v", perf, tt.labels)
+			}
+			for i, label := range tt.labels {
+				if perf[i].Label != label {
+					t.Errorf("第 %d 项标签 = %q, 期望 %q", i, perf[i].Label, label)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,328 @@
+package collectors
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"GoMonitor/proto"
+)
+
+// DefaultProcRoot 是proc文件系统的默认挂载点
+const DefaultProcRoot = "/proc"
+
+// maxReportedConnections 限制每次上报的连接数量，连接很多的主机只上报前若干条，状态统计不受影响
+const maxReportedConnections = 200
+
+// defaultEphemeralPorts 是内核默认的临时端口范围，无法读取 ip_local_port_range 时使用
+var defaultEphemeralPorts = portRange{low: 32768, high: 60999}
+
+// tcpStates 是内核 include/net/tcp_states.h 中定义的TCP状态
+var tcpStates = map[string]string{
+	"01": "ESTABLISHED",
+	"02": "SYN_SENT",
+	"03": "SYN_RECV",
+	"04": "FIN_WAIT1",
+	"05": "FIN_WAIT2",
+	"06": "TIME_WAIT",
+	"07": "CLOSE",
+	"08": "CLOSE_WAIT",
+	"09": "LAST_ACK",
+	"0A": "LISTEN",
+	"0B": "CLOSING",
+}
+
+// socketEntry 是 /proc/net/{tcp,udp}{,6} 中的一行
+type socketEntry struct {
+	protocol   string
+	localAddr  string
+	localPort  int32
+	remoteAddr string
+	remotePort int32
+	state      string
+	inode      string
+}
+
+// portRange 是一个闭区间的端口范围
+type portRange struct {
+	low, high int32
+}
+
+func (r portRange) contains(port int32) bool {
+	return port >= r.low && port <= r.high
+}
+
+// ephemeralPorts 是内核为未指定端口的套接字自动分配端口时使用的范围，以及其中被保留、不会自动分配的端口
+type ephemeralPorts struct {
+	local    portRange
+	reserved []portRange
+}
+
+// automatic 判断端口是否可能是内核自动分配的临时端口
+func (e ephemeralPorts) automatic(port int32) bool {
+	if !e.local.contains(port) {
+		return false
+	}
+	for _, r := range e.reserved {
+		if r.contains(port) {
+			return false
+		}
+	}
+	return true
+}
+
+// ConnectionCollector 解析 /proc/net 下的套接字表，上报监听端口及其所属进程、TCP连接状态统计和当前连接
+type ConnectionCollector struct {
+	procRoot string
+}
+
+// NewConnectionCollector 创建连接采集器，procRoot为空时使用DefaultProcRoot
+func NewConnectionCollector(procRoot string) *ConnectionCollector {
+	if procRoot == "" {
+		procRoot = DefaultProcRoot
+	}
+	return &ConnectionCollector{procRoot: procRoot}
+}
+
+// Name 返回采集器名称
+func (c *ConnectionCollector) Name() string {
+	return "connections"
+}
+
+// Collect 实现Collector接口
+// 没有权限读取其他用户进程的fd时，对应套接字的进程信息为空
+func (c *ConnectionCollector) Collect(ctx context.Context, opts Options, info *proto.SystemInfo) error {
+	var entries []socketEntry
+	for _, protocol := range []string{"tcp", "tcp6", "udp", "udp6"} {
+		parsed, err := readSocketTable(filepath.Join(c.procRoot, "net", protocol), protocol)
+		if os.IsNotExist(err) {
+			// 内核未启用IPv6时没有tcp6/udp6
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		entries = append(entries, parsed...)
+	}
+
+	owners := c.socketOwners(ctx)
+	ephemeral := readEphemeralPorts(filepath.Join(c.procRoot, "sys/net/ipv4"))
+	connInfo := &proto.ConnectionInfo{
+		TcpStates: make(map[string]int32),
+	}
+
+	for _, entry := range entries {
+		isTCP := strings.HasPrefix(entry.protocol, "tcp")
+		if isTCP {
+			connInfo.TcpStates[entry.state]++
+		}
+
+		// 未连接的UDP套接字（远端端口为0）等同于监听，但DNS查询等客户端套接字也未连接，
+		// 端口由内核从临时端口范围中随机分配，每次采集都不同，不视为监听
+		listening := entry.state == "LISTEN" ||
+			(!isTCP && entry.remotePort == 0 && !ephemeral.automatic(entry.localPort))
+		if listening {
+			owner := owners[entry.inode]
+			connInfo.Listening = append(connInfo.Listening, &proto.ListeningSocket{
+				Protocol: entry.protocol,
+				Address:  entry.localAddr,
+				Port:     entry.localPort,
+				Pid:      owner.pid,
+				Process:  owner.name,
+			})
+			continue
+		}
+
+		if !isTCP || len(connInfo.Connections) >= maxReportedConnections {
+			continue
+		}
+		owner := owners[entry.inode]
+		connInfo.Connections = append(connInfo.Connections, &proto.Connection{
+			Protocol:      entry.protocol,
+			LocalAddress:  entry.localAddr,
+			LocalPort:     entry.localPort,
+			RemoteAddress: entry.remoteAddr,
+			RemotePort:    entry.remotePort,
+			State:         entry.state,
+			Pid:           owner.pid,
+			Process:       owner.name,
+		})
+	}
+
+	sort.Slice(connInfo.Listening, func(i, j int) bool {
+		a, b := connInfo.Listening[i], connInfo.Listening[j]
+		if a.Port != b.Port {
+			return a.Port < b.Port
+		}
+		if a.Protocol != b.Protocol {
+			return a.Protocol < b.Protocol
+		}
+		return a.Address < b.Address
+	})
+
+	info.ConnectionInfo = connInfo
+	return nil
+}
+
+// readEphemeralPorts 读取 ip_local_port_range 和 ip_local_reserved_ports（同时适用于IPv4和IPv6），无法读取时使用内核默认值
+func readEphemeralPorts(dir string) ephemeralPorts {
+	ports := ephemeralPorts{local: defaultEphemeralPorts}
+
+	if fields := strings.Fields(readStringFile(filepath.Join(dir, "ip_local_port_range"))); len(fields) == 2 {
+		low, errLow := strconv.ParseUint(fields[0], 10, 16)
+		high, errHigh := strconv.ParseUint(fields[1], 10, 16)
+		if errLow == nil && errHigh == nil && low <= high {
+			ports.local = portRange{low: int32(low), high: int32(high)}
+		}
+	}
+
+	// 格式为 "8080,9000-9100"，未保留任何端口时为空
+	for _, item := range strings.Split(readStringFile(filepath.Join(dir, "ip_local_reserved_ports")), ",") {
+		lowText, highText, isRange := strings.Cut(strings.TrimSpace(item), "-")
+		if !isRange {
+			highText = lowText
+		}
+		low, errLow := strconv.ParseUint(lowText, 10, 16)
+		high, errHigh := strconv.ParseUint(highText, 10, 16)
+		if errLow == nil && errHigh == nil && low <= high {
+			ports.reserved = append(ports.reserved, portRange{low: int32(low), high: int32(high)})
+		}
+	}
+	return ports
+}
+
+// socketOwner 是持有套接字的进程
+type socketOwner struct {
+	pid  int64
+	name string
+}
+
+// socketOwners 遍历 /proc/<pid>/fd 建立套接字inode到进程的映射
+// 多个进程共享同一套接字（例如fork后的worker）时取pid最小的进程
+func (c *ConnectionCollector) socketOwners(ctx context.Context) map[string]socketOwner {
+	owners := make(map[string]socketOwner)
+
+	procs, err := os.ReadDir(c.procRoot)
+	if err != nil {
+		return owners
+	}
+
+	for _, proc := range procs {
+		if ctx.Err() != nil {
+			break
+		}
+
+		pid, err := strconv.ParseInt(proc.Name(), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		fdDir := filepath.Join(c.procRoot, proc.Name(), "fd")
+		fds, err := os.ReadDir(fdDir)
+		if err != nil {
+			// 进程已退出或没有权限
+			continue
+		}
+
+		var name string
+		for _, fd := range fds {
+			target, err := os.Readlink(filepath.Join(fdDir, fd.Name()))
+			if err != nil || !strings.HasPrefix(target, "socket:[") {
+				continue
+			}
+			inode := strings.TrimSuffix(strings.TrimPrefix(target, "socket:["), "]")
+
+			if existing, ok := owners[inode]; ok && existing.pid < pid {
+				continue
+			}
+			if name == "" {
+				name = readStringFile(filepath.Join(c.procRoot, proc.Name(), "comm"))
+			}
+			owners[inode] = socketOwner{pid: pid, name: name}
+		}
+	}
+
+	return owners
+}
+
+// readSocketTable 解析 /proc/net/tcp 等文件，格式如下（首行为表头）：
+//
+//	sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode
+//	0: 0100007F:0035 00000000:0000 0A 00000000:00000000 00:00000000 00000000   101        0 17423 ...
+func readSocketTable(path string, protocol string) ([]socketEntry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var entries []socketEntry
+	scanner := bufio.NewScanner(file)
+	scanner.Scan() // 跳过表头
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 10 {
+			continue
+		}
+
+		localAddr, localPort, err := parseSocketAddress(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("%s 格式错误: %w", path, err)
+		}
+		remoteAddr, remotePort, err := parseSocketAddress(fields[2])
+		if err != nil {
+			return nil, fmt.Errorf("%s 格式错误: %w", path, err)
+		}
+
+		state := fields[3]
+		if name, ok := tcpStates[state]; ok {
+			state = name
+		}
+
+		entries = append(entries, socketEntry{
+			protocol:   protocol,
+			localAddr:  localAddr,
+			localPort:  localPort,
+			remoteAddr: remoteAddr,
+			remotePort: remotePort,
+			state:      state,
+			inode:      fields[9],
+		})
+	}
+
+	return entries, scanner.Err()
+}
+
+// parseSocketAddress 解析 "0100007F:0035" 形式的地址
+// 地址按32位字以主机字节序（小端）存储，IPv6地址由4个这样的字组成
+func parseSocketAddress(s string) (string, int32, error) {
+	hexIP, hexPort, found := strings.Cut(s, ":")
+	if !found {
+		return "", 0, fmt.Errorf("无效的地址 %q", s)
+	}
+
+	raw, err := hex.DecodeString(hexIP)
+	if err != nil || (len(raw) != net.IPv4len && len(raw) != net.IPv6len) {
+		return "", 0, fmt.Errorf("无效的地址 %q", s)
+	}
+
+	ip := make(net.IP, len(raw))
+	for i := 0; i < len(raw); i += 4 {
+		binary.BigEndian.PutUint32(ip[i:], binary.LittleEndian.Uint32(raw[i:]))
+	}
+
+	port, err := strconv.ParseUint(hexPort, 16, 16)
+	if err != nil {
+		return "", 0, fmt.Errorf("无效的端口 %q", s)
+	}
+
+	return ip.String(), int32(port), nil
+}
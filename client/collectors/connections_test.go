@@ -0,0 +1,109 @@
+package collectors
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"GoMonitor/proto"
+)
+
+const socketTableHeader = "  sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode\n"
+
+func TestParseSocketAddress(t *testing.T) {
+	tests := []struct {
+		input   string
+		addr    string
+		port    int32
+		wantErr bool
+	}{
+		{"0100007F:0035", "127.0.0.1", 53, false},
+		{"0F02000A:C350", "10.0.2.15", 50000, false},
+		{"00000000000000000000000001000000:0016", "::1", 22, false},
+		{"0000000000000000FFFF00000F02000A:01BB", "10.0.2.15", 443, false},
+		{"0100007F", "", 0, true},
+		{"0100:0035", "", 0, true},
+		{"0100007F:XYZ", "", 0, true},
+	}
+
+	for _, tt := range tests {
+		addr, port, err := parseSocketAddress(tt.input)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("parseSocketAddress(%q) 应返回错误", tt.input)
+			}
+			continue
+		}
+		if err != nil || addr != tt.addr || port != tt.port {
+			t.Errorf("parseSocketAddress(%q) = %s, %d, %v，期望 %s, %d", tt.input, addr, port, err, tt.addr, tt.port)
+		}
+	}
+}
+
+func TestReadEphemeralPorts(t *testing.T) {
+	dir := t.TempDir()
+	writeFixture(t, dir, map[string]string{
+		"ip_local_port_range":     "40000\t50000\n",
+		"ip_local_reserved_ports": "8080,45000-45010\n",
+	})
+
+	ports := readEphemeralPorts(dir)
+	cases := map[int32]bool{39999: false, 40000: true, 44999: true, 45005: false, 50000: true, 50001: false, 8080: false}
+	for port, want := range cases {
+		if got := ports.automatic(port); got != want {
+			t.Errorf("automatic(%d) = %v，期望 %v", port, got, want)
+		}
+	}
+
+	// 文件不存在时使用内核默认范围
+	if ports := readEphemeralPorts(filepath.Join(dir, "missing")); !ports.automatic(32768) || ports.automatic(61000) {
+		t.Errorf("默认临时端口范围错误: %+v", ports)
+	}
+}
+
+func TestConnectionCollector(t *testing.T) {
+	root := t.TempDir()
+	writeFixture(t, root, map[string]string{
+		"net/tcp": socketTableHeader +
+			"   0: 0100007F:0035 00000000:0000 0A 00000000:00000000 00:00000000 00000000   101        0 17423 1 0 100 0 0 10 0\n" +
+			"   1: 0F02000A:C350 0100000A:01BB 01 00000000:00000000 00:00000000 00000000  1000        0 28811 1 0 20 4 30 10 -1\n" +
+			"   2: 0F02000A:C351 0100000A:01BB 06 00000000:00000000 00:00000000 00000000     0        0 0 3 0\n",
+		"net/udp": socketTableHeader +
+			"   0: 00000000:0202 00000000:0000 07 00000000:00000000 00:00000000 00000000     0        0 1111 2 0 0\n" +
+			// 临时端口上的未连接套接字（例如DNS查询）
+			"   1: 00000000:D431 00000000:0000 07 00000000:00000000 00:00000000 00000000  1000        0 2222 2 0 0\n" +
+			// 临时端口范围内但已被保留的端口
+			"   2: 00000000:EA60 00000000:0000 07 00000000:00000000 00:00000000 00000000     0        0 3333 2 0 0\n" +
+			// 已连接的UDP套接字
+			"   3: 0F02000A:9C40 0100000A:0035 01 00000000:00000000 00:00000000 00000000  1000        0 4444 2 0 0\n",
+		"net/udp6": socketTableHeader +
+			"   0: 00000000000000000000000001000000:0035 00000000000000000000000000000000:0000 07 00000000:00000000 00:00000000 00000000   101        0 5555 2 0 0\n",
+		"sys/net/ipv4/ip_local_port_range":     "32768\t60999\n",
+		"sys/net/ipv4/ip_local_reserved_ports": "60000-60010\n",
+	})
+
+	info := &proto.SystemInfo{}
+	if err := NewConnectionCollector(root).Collect(context.Background(), Options{}, info); err != nil {
+		t.Fatal(err)
+	}
+	conn := info.ConnectionInfo
+
+	want := []string{"tcp 127.0.0.1:53", "udp6 ::1:53", "udp 0.0.0.0:514", "udp 0.0.0.0:60000"}
+	if len(conn.Listening) != len(want) {
+		t.Fatalf("监听端口 %v，期望 %v", conn.Listening, want)
+	}
+	for i, socket := range conn.Listening {
+		got := fmt.Sprintf("%s %s:%d", socket.Protocol, socket.Address, socket.Port)
+		if got != want[i] {
+			t.Errorf("第 %d 个监听端口为 %s，期望 %s", i, got, want[i])
+		}
+	}
+
+	if conn.TcpStates["ESTABLISHED"] != 1 || conn.TcpStates["TIME_WAIT"] != 1 || conn.TcpStates["LISTEN"] != 1 {
+		t.Errorf("TCP状态统计错误: %v", conn.TcpStates)
+	}
+	if len(conn.Connections) != 2 || conn.Connections[0].RemotePort != 443 {
+		t.Errorf("TCP连接错误: %v", conn.Connections)
+	}
+}
@@ -1,45 +1,124 @@
 package collectors
 
 import (
+	"context"
 	"runtime"
-	"time"
+	"sync"
 
 	"GoMonitor/proto"
 	"github.com/shirou/gopsutil/cpu"
 	"github.com/shirou/gopsutil/load"
 )
 
-func CollectCPUInfo() (*proto.CPUInfo, error) {
-	cpuPercent, err := cpu.Percent(time.Second, false)
-	if err != nil {
-		return nil, err
+// cpuTopology 是不随时间变化的CPU元数据，只在第一次采集时读取
+type cpuTopology struct {
+	modelName     string
+	mhz           float64
+	sockets       int
+	physicalCores int
+	logicalCores  int
+}
+
+// CPUCollector 采集CPU使用率、负载和型号信息
+// 使用率是距上一次上报的整个窗口内的平均值，采集时不会阻塞
+type CPUCollector struct {
+	sampler      *CPUSampler
+	topologyOnce sync.Once
+	topology     cpuTopology
+}
+
+// NewCPUCollector 创建CPU采集器，并以当前时刻作为第一个统计窗口的起点
+func NewCPUCollector() *CPUCollector {
+	return &CPUCollector{
+		sampler: NewCPUSampler(),
 	}
+}
 
-	corePercents, err := cpu.Percent(time.Second, true)
+// Name 返回采集器名称
+func (c *CPUCollector) Name() string {
+	return "cpu"
+}
+
+// Collect 实现Collector接口
+func (c *CPUCollector) Collect(ctx context.Context, opts Options, info *proto.SystemInfo) error {
+	cpuInfo, err := c.CollectCPUInfo(ctx, !opts.Peek)
 	if err != nil {
-		corePercents = []float64{}
+		return err
 	}
+	info.CpuInfo = cpuInfo
+	return nil
+}
 
-	counts, err := cpu.Counts(true)
+// CollectCPUInfo 收集CPU信息，advance表示是否开始新的统计窗口
+func (c *CPUCollector) CollectCPUInfo(ctx context.Context, advance bool) (*proto.CPUInfo, error) {
+	usage, err := c.sampler.Sample(ctx, advance)
 	if err != nil {
-		counts = runtime.NumCPU()
+		return nil, err
 	}
 
-	var loadAvg1, loadAvg5, loadAvg15 int64
+	c.topologyOnce.Do(func() {
+		c.topology = readCPUTopology(ctx)
+	})
 
-	loadAvgStat, err := load.Avg()
+	cpuInfo := &proto.CPUInfo{
+		CpuUsagePercent:   usage.Usage,
+		CpuCores:          int32(c.topology.logicalCores),
+		CoreUsagePercents: usage.Cores,
+		UserPercent:       usage.User,
+		SystemPercent:     usage.System,
+		IowaitPercent:     usage.Iowait,
+		StealPercent:      usage.Steal,
+		IdlePercent:       usage.Idle,
+		SampleSeconds:     usage.Elapsed.Seconds(),
+		ModelName:         c.topology.modelName,
+		Mhz:               c.topology.mhz,
+		Sockets:           int32(c.topology.sockets),
+		PhysicalCores:     int32(c.topology.physicalCores),
+		LogicalCores:      int32(c.topology.logicalCores),
+	}
+
+	loadAvgStat, err := load.AvgWithContext(ctx)
 	if err == nil {
-		loadAvg1 = int64(loadAvgStat.Load1 * 100)
-		loadAvg5 = int64(loadAvgStat.Load5 * 100)
-		loadAvg15 = int64(loadAvgStat.Load15 * 100)
-	}
-
-	return &proto.CPUInfo{
-		CpuUsagePercent:   cpuPercent[0],
-		CpuCores:          int32(counts),
-		CoreUsagePercents: corePercents,
-		LoadAverage_1M:    loadAvg1,
-		LoadAverage_5M:    loadAvg5,
-		LoadAverage_15M:   loadAvg15,
-	}, nil
+		cpuInfo.Load_1M = loadAvgStat.Load1
+		cpuInfo.Load_5M = loadAvgStat.Load5
+		cpuInfo.Load_15M = loadAvgStat.Load15
+
+		// 旧版本服务端只识别乘以100后的整数字段
+		cpuInfo.LoadAverage_1M = int64(loadAvgStat.Load1 * 100)
+		cpuInfo.LoadAverage_5M = int64(loadAvgStat.Load5 * 100)
+		cpuInfo.LoadAverage_15M = int64(loadAvgStat.Load15 * 100)
+	}
+
+	return cpuInfo, nil
+}
+
+// readCPUTopology 读取CPU型号、主频和核心数，读取失败的项保持为零
+func readCPUTopology(ctx context.Context) cpuTopology {
+	topology := cpuTopology{
+		logicalCores: runtime.NumCPU(),
+	}
+
+	if counts, err := cpu.CountsWithContext(ctx, true); err == nil && counts > 0 {
+		topology.logicalCores = counts
+	}
+	if counts, err := cpu.CountsWithContext(ctx, false); err == nil {
+		topology.physicalCores = counts
+	}
+
+	infos, err := cpu.InfoWithContext(ctx)
+	if err != nil || len(infos) == 0 {
+		return topology
+	}
+
+	topology.modelName = infos[0].ModelName
+	sockets := make(map[string]bool)
+	for _, info := range infos {
+		if info.Mhz > topology.mhz {
+			topology.mhz = info.Mhz
+		}
+		sockets[info.PhysicalID] = true
+	}
+	topology.sockets = len(sockets)
+
+	return topology
 }
@@ -0,0 +1,139 @@
+package collectors
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/shirou/gopsutil/cpu"
+)
+
+// CPUUsage 是两次 /proc/stat 采样之间的CPU时间占比（百分比）
+type CPUUsage struct {
+	Usage   float64 // 非空闲时间占比，不含iowait
+	Idle    float64
+	User    float64
+	System  float64
+	Iowait  float64
+	Steal   float64
+	Cores   []float64 // 各核心的使用率
+	Elapsed time.Duration
+}
+
+// CPUSampler 保存上一次的CPU时间计数器，按两次采样的差值计算使用率
+// 使用率覆盖两次上报之间的整个窗口，且计算时无需等待
+type CPUSampler struct {
+	mu        sync.Mutex
+	prevTotal cpu.TimesStat
+	prevCores []cpu.TimesStat
+	prevAt    time.Time
+	last      *CPUUsage
+}
+
+// NewCPUSampler 创建采样器并记录初始基准
+// 读取计数器失败时基准为空，第一次采样将得到开机以来的平均使用率
+func NewCPUSampler() *CPUSampler {
+	s := &CPUSampler{}
+	if total, cores, err := readCPUTimes(context.Background()); err == nil {
+		s.prevTotal, s.prevCores, s.prevAt = total, cores, time.Now()
+	}
+	return s
+}
+
+// Sample 计算自上一次基准以来的CPU使用率
+// advance为true时把本次计数器作为新的基准；按需查询应传false，以免缩短下一次上报的统计窗口
+func (s *CPUSampler) Sample(ctx context.Context, advance bool) (*CPUUsage, error) {
+	total, cores, err := readCPUTimes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// 距上次基准过近时计数器几乎没有变化，沿用上一次的结果
+	if total.Total()-s.prevTotal.Total() <= 0 && s.last != nil {
+		return s.last, nil
+	}
+
+	usage := cpuUsageBetween(s.prevTotal, total)
+	usage.Elapsed = now.Sub(s.prevAt)
+	if s.prevAt.IsZero() {
+		usage.Elapsed = 0
+	}
+
+	// CPU热插拔会改变核心数量，此时各核心从零开始计算
+	prevCores := s.prevCores
+	if len(prevCores) != len(cores) {
+		prevCores = make([]cpu.TimesStat, len(cores))
+	}
+	usage.Cores = make([]float64, len(cores))
+	for i := range cores {
+		usage.Cores[i] = cpuUsageBetween(prevCores[i], cores[i]).Usage
+	}
+
+	if advance {
+		s.prevTotal, s.prevCores, s.prevAt = total, cores, now
+		s.last = usage
+	}
+	return usage, nil
+}
+
+// readCPUTimes 读取总体和各核心的累计CPU时间
+func readCPUTimes(ctx context.Context) (cpu.TimesStat, []cpu.TimesStat, error) {
+	totals, err := cpu.TimesWithContext(ctx, false)
+	if err != nil {
+		return cpu.TimesStat{}, nil, err
+	}
+	if len(totals) == 0 {
+		return cpu.TimesStat{}, nil, fmt.Errorf("无法读取CPU时间")
+	}
+
+	cores, err := cpu.TimesWithContext(ctx, true)
+	if err != nil {
+		return cpu.TimesStat{}, nil, err
+	}
+	return totals[0], cores, nil
+}
+
+// cpuUsageBetween 计算两组累计CPU时间之间的各项占比
+// 计数器回退（例如重启后基准失效）时把t1视为零，即计算t2自开机以来的平均值
+func cpuUsageBetween(t1, t2 cpu.TimesStat) *CPUUsage {
+	if t2.Total() < t1.Total() {
+		t1 = cpu.TimesStat{}
+	}
+
+	delta := t2.Total() - t1.Total()
+	if delta <= 0 {
+		return &CPUUsage{}
+	}
+
+	percent := func(a, b float64) float64 {
+		p := (b - a) / delta * 100
+		if p < 0 {
+			return 0
+		}
+		if p > 100 {
+			return 100
+		}
+		return p
+	}
+
+	idle := percent(t1.Idle, t2.Idle)
+	iowait := percent(t1.Iowait, t2.Iowait)
+	usage := 100 - idle - iowait
+	if usage < 0 {
+		usage = 0
+	}
+
+	return &CPUUsage{
+		Usage:  usage,
+		Idle:   idle,
+		User:   percent(t1.User+t1.Nice, t2.User+t2.Nice),
+		System: percent(t1.System+t1.Irq+t1.Softirq, t2.System+t2.Irq+t2.Softirq),
+		Iowait: iowait,
+		Steal:  percent(t1.Steal, t2.Steal),
+	}
+}
@@ -0,0 +1,56 @@
+package collectors
+
+import (
+	"math"
+	"testing"
+
+	"github.com/shirou/gopsutil/cpu"
+)
+
+func TestCPUUsageBetween(t *testing.T) {
+	base := cpu.TimesStat{User: 100, System: 50, Idle: 800, Iowait: 50}
+	tests := []struct {
+		name   string
+		t1, t2 cpu.TimesStat
+		want   CPUUsage
+	}{
+		{
+			name: "正常增量",
+			t1:   base,
+			t2:   cpu.TimesStat{User: 130, Nice: 10, System: 60, Irq: 5, Softirq: 5, Idle: 830, Iowait: 60},
+			// 总增量100：user+nice 40，system+irq+softirq 20，idle 30，iowait 10
+			want: CPUUsage{Usage: 60, Idle: 30, User: 40, System: 20, Iowait: 10},
+		},
+		{
+			name: "计数器回退时按开机以来计算",
+			t1:   base,
+			t2:   cpu.TimesStat{User: 20, System: 10, Idle: 60, Iowait: 5, Steal: 5},
+			want: CPUUsage{Usage: 35, Idle: 60, User: 20, System: 10, Iowait: 5, Steal: 5},
+		},
+		{
+			name: "没有变化",
+			t1:   base,
+			t2:   base,
+			want: CPUUsage{},
+		},
+		{
+			name: "空闲计数器单独回退不产生负数",
+			t1:   base,
+			t2:   cpu.TimesStat{User: 300, System: 50, Idle: 790, Iowait: 50},
+			want: CPUUsage{Usage: 100, User: 100},
+		},
+	}
+
+	near := func(a, b float64) bool {
+		return math.Abs(a-b) < 1e-9
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := cpuUsageBetween(tt.t1, tt.t2)
+			if !near(got.Usage, tt.want.Usage) || !near(got.Idle, tt.want.Idle) || !near(got.User, tt.want.User) ||
+				!near(got.System, tt.want.System) || !near(got.Iowait, tt.want.Iowait) || !near(got.Steal, tt.want.Steal) {
+				t.Fatalf("cpuUsageBetween = %+v, 期望 %+v", *got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,385 @@
+package collectors
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"GoMonitor/proto"
+)
+
+// DefaultCronRoot 是查找cron配置的根目录
+const DefaultCronRoot = "/"
+
+// maxCrontabLine 是crontab中单行的最大长度
+const maxCrontabLine = 64 * 1024
+
+// cronPeriodDirs 是按固定周期由run-parts执行的脚本目录及其对应的执行时间
+var cronPeriodDirs = []struct {
+	dir      string
+	schedule string
+}{
+	{"etc/cron.hourly", "@hourly"},
+	{"etc/cron.daily", "@daily"},
+	{"etc/cron.weekly", "@weekly"},
+	{"etc/cron.monthly", "@monthly"},
+}
+
+// userCrontabDirs 是各发行版保存用户crontab的目录，文件名即用户名
+var userCrontabDirs = []string{
+	"var/spool/cron/crontabs", // Debian、Ubuntu
+	"var/spool/cron",          // RHEL、Fedora、SUSE
+}
+
+// cronScheduleAliases 将同义的@关键字统一为一种写法
+var cronScheduleAliases = map[string]string{
+	"@annually": "@yearly",
+	"@midnight": "@daily",
+}
+
+var (
+	// runPartsNamePattern 是run-parts和cron.d接受的文件名，带"."的文件（如dpkg留下的 .dpkg-old）会被忽略
+	runPartsNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+	// cronEnvPattern 匹配crontab中的环境变量设置
+	cronEnvPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*\s*=`)
+	// execArgvPattern 取出systemctl show中ExecStart的命令行
+	execArgvPattern = regexp.MustCompile(`argv\[\]=(.*?) ; `)
+)
+
+// CronCollector 上报系统crontab、cron.d、用户crontab、/etc/cron.* 中的脚本和处于活动状态的systemd定时器
+// 用户crontab目录通常只有root可读，无法读取时不上报用户任务
+type CronCollector struct {
+	root string
+}
+
+// NewCronCollector 创建计划任务采集器，root为空时使用DefaultCronRoot
+func NewCronCollector(root string) *CronCollector {
+	if root == "" {
+		root = DefaultCronRoot
+	}
+	return &CronCollector{root: root}
+}
+
+// Name 返回采集器名称
+func (c *CronCollector) Name() string {
+	return "cron"
+}
+
+// Collect 实现Collector接口
+// 既没有cron配置目录也未以systemd启动的系统（例如Windows）不上报
+func (c *CronCollector) Collect(ctx context.Context, opts Options, info *proto.SystemInfo) error {
+	inventory := &proto.ScheduledJobInventory{}
+	supported := false
+
+	crontab := filepath.Join(c.root, "etc/crontab")
+	if jobs, err := readCrontab(crontab, "crontab", ""); err == nil {
+		supported = true
+		inventory.Jobs = append(inventory.Jobs, jobs...)
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	cronDir := filepath.Join(c.root, "etc/cron.d")
+	if files, err := runPartsFiles(cronDir, false); err == nil {
+		supported = true
+		for _, file := range files {
+			jobs, err := readCrontab(file, "cron.d", "")
+			if err != nil {
+				continue
+			}
+			inventory.Jobs = append(inventory.Jobs, jobs...)
+		}
+	}
+
+	for _, period := range cronPeriodDirs {
+		files, err := runPartsFiles(filepath.Join(c.root, period.dir), true)
+		if err != nil {
+			continue
+		}
+		supported = true
+		for _, file := range files {
+			inventory.Jobs = append(inventory.Jobs, &proto.ScheduledJob{
+				Source:   filepath.Base(period.dir),
+				File:     file,
+				User:     "root",
+				Schedule: period.schedule,
+				Command:  file,
+			})
+		}
+	}
+
+	inventory.Jobs = append(inventory.Jobs, c.userCrontabs()...)
+
+	// systemctl查询的总是本机，指定了其他根目录时不上报定时器
+	if _, err := os.Stat(systemdRuntimeDir); err == nil && c.root == DefaultCronRoot {
+		supported = true
+		timers, err := systemdTimers(ctx)
+		if err != nil {
+			return err
+		}
+		inventory.Jobs = append(inventory.Jobs, timers...)
+	}
+
+	if !supported {
+		return nil
+	}
+	sort.SliceStable(inventory.Jobs, func(i, j int) bool {
+		a, b := inventory.Jobs[i], inventory.Jobs[j]
+		if a.Source != b.Source {
+			return a.Source < b.Source
+		}
+		return a.File < b.File
+	})
+	info.ScheduledJobs = inventory
+	return nil
+}
+
+// userCrontabs 读取各用户的crontab
+func (c *CronCollector) userCrontabs() []*proto.ScheduledJob {
+	var jobs []*proto.ScheduledJob
+	for _, dir := range userCrontabDirs {
+		entries, err := os.ReadDir(filepath.Join(c.root, dir))
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			// Debian的 /var/spool/cron 下是crontabs等子目录，跳过目录和crontab编辑时留下的临时文件
+			if !entry.Type().IsRegular() || strings.HasPrefix(entry.Name(), "tmp.") {
+				continue
+			}
+			path := filepath.Join(c.root, dir, entry.Name())
+			userJobs, err := readCrontab(path, "user", entry.Name())
+			if err != nil {
+				continue
+			}
+			jobs = append(jobs, userJobs...)
+		}
+	}
+	return jobs
+}
+
+// runPartsFiles 按名称顺序返回目录中会被执行的文件，executable为true时只返回可执行文件
+func runPartsFiles(dir string, executable bool) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for _, entry := range entries {
+		if !runPartsNamePattern.MatchString(entry.Name()) {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		// 与run-parts一致，跟随符号链接判断
+		info, err := os.Stat(path)
+		if err != nil || !info.Mode().IsRegular() {
+			continue
+		}
+		if executable && info.Mode().Perm()&0o111 == 0 {
+			continue
+		}
+		files = append(files, path)
+	}
+	return files, nil
+}
+
+// readCrontab 解析crontab文件；user为空时为系统crontab格式，执行时间之后是用户字段
+func readCrontab(path string, source string, user string) ([]*proto.ScheduledJob, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var jobs []*proto.ScheduledJob
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 4096), maxCrontabLine)
+	for scanner.Scan() {
+		job := parseCrontabLine(scanner.Text(), user == "")
+		if job == nil {
+			continue
+		}
+		job.Source = source
+		job.File = path
+		if user != "" {
+			job.User = user
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, scanner.Err()
+}
+
+// parseCrontabLine 解析crontab中的一行，空行、注释和环境变量设置返回nil
+// system为true时执行时间之后的字段是执行命令的用户
+func parseCrontabLine(line string, system bool) *proto.ScheduledJob {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") || cronEnvPattern.MatchString(line) {
+		return nil
+	}
+
+	scheduleFields := 5
+	if strings.HasPrefix(line, "@") {
+		scheduleFields = 1
+	}
+	fieldCount := scheduleFields + 1
+	if system {
+		fieldCount++
+	}
+	fields := strings.Fields(line)
+	if len(fields) < fieldCount {
+		return nil
+	}
+
+	// 命令保留原有的空白，从第fieldCount-1个字段之后的原文中取出
+	rest := line
+	for i := 0; i < fieldCount-1; i++ {
+		rest = strings.TrimLeft(rest, " \t")
+		rest = rest[len(fields[i]):]
+	}
+
+	job := &proto.ScheduledJob{
+		Schedule: strings.Join(fields[:scheduleFields], " "),
+		Command:  strings.TrimSpace(rest),
+	}
+	if alias, ok := cronScheduleAliases[job.Schedule]; ok {
+		job.Schedule = alias
+	}
+	if system {
+		job.User = fields[scheduleFields]
+	}
+	return job
+}
+
+// systemdTimers 查询处于活动状态的systemd定时器及其触发的服务
+func systemdTimers(ctx context.Context) ([]*proto.ScheduledJob, error) {
+	result, err := runExternal(ctx, systemctlTimeout, "systemctl",
+		[]string{"list-units", "--type=timer", "--state=active", "--plain", "--no-legend", "--no-pager", "--full"})
+	if err != nil {
+		return nil, fmt.Errorf("查询定时器出错: %w", err)
+	}
+	if result.ExitCode != 0 {
+		return nil, fmt.Errorf("查询定时器出错: systemctl退出码 %d: %s", result.ExitCode, result.Stderr)
+	}
+
+	var names []string
+	scanner := bufio.NewScanner(bytes.NewReader(result.Stdout))
+	for scanner.Scan() {
+		if fields := strings.Fields(scanner.Text()); len(fields) > 0 && ValidateUnitName(fields[0]) == nil {
+			names = append(names, fields[0])
+		}
+	}
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	timers, err := showUnits(ctx, names, "Id,Unit,TimersCalendar,TimersMonotonic")
+	if err != nil {
+		return nil, err
+	}
+
+	var services []string
+	for _, timer := range timers {
+		if service := timer["Unit"]; len(service) > 0 && !containsString(services, service[0]) {
+			services = append(services, service[0])
+		}
+	}
+	serviceProps := make(map[string]map[string][]string)
+	if len(services) > 0 {
+		units, err := showUnits(ctx, services, "Id,ExecStart,User")
+		if err != nil {
+			return nil, err
+		}
+		for i, unit := range units {
+			serviceProps[services[i]] = unit
+		}
+	}
+
+	jobs := make([]*proto.ScheduledJob, 0, len(timers))
+	for i, timer := range timers {
+		job := &proto.ScheduledJob{
+			Source:   "systemd",
+			File:     names[i],
+			User:     "root",
+			Schedule: timerSchedule(timer),
+		}
+		if service := timer["Unit"]; len(service) > 0 {
+			props := serviceProps[service[0]]
+			if user := props["User"]; len(user) > 0 && user[0] != "" {
+				job.User = user[0]
+			}
+			var commands []string
+			for _, exec := range props["ExecStart"] {
+				if m := execArgvPattern.FindStringSubmatch(exec); m != nil {
+					commands = append(commands, m[1])
+				}
+			}
+			job.Command = strings.Join(commands, "; ")
+			if job.Command == "" {
+				job.Command = service[0]
+			}
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+// showUnits 查询单元的属性，结果顺序与names一致；同名属性可能出现多次（如多个OnCalendar）
+func showUnits(ctx context.Context, names []string, properties string) ([]map[string][]string, error) {
+	args := append([]string{"show", "--no-pager", "--property=" + properties}, names...)
+	result, err := runExternal(ctx, systemctlTimeout, "systemctl", args)
+	if err != nil {
+		return nil, fmt.Errorf("查询单元属性出错: %w", err)
+	}
+	if result.ExitCode != 0 {
+		return nil, fmt.Errorf("查询单元属性出错: systemctl退出码 %d: %s", result.ExitCode, result.Stderr)
+	}
+
+	var units []map[string][]string
+	var current map[string][]string
+	scanner := bufio.NewScanner(bytes.NewReader(result.Stdout))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			current = nil
+			continue
+		}
+		if current == nil {
+			current = make(map[string][]string)
+			units = append(units, current)
+		}
+		key, value, _ := strings.Cut(line, "=")
+		current[key] = append(current[key], value)
+	}
+	if len(units) != len(names) {
+		return nil, fmt.Errorf("查询单元属性出错: 请求 %d 个单元，systemctl返回 %d 个", len(names), len(units))
+	}
+	return units, nil
+}
+
+// timerSchedule 将定时器的触发条件整理为一行，例如 "OnCalendar=daily; OnBootSec=15min"
+// systemctl show 的格式为 "{ OnCalendar=daily ; next_elapse=... }"，只保留触发条件，下次触发时间不计入
+func timerSchedule(timer map[string][]string) string {
+	var triggers []string
+	for _, key := range []string{"TimersCalendar", "TimersMonotonic"} {
+		for _, value := range timer[key] {
+			value = strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(value, "{"), "}"))
+			trigger, _, _ := strings.Cut(value, " ; ")
+			if trigger = strings.TrimSpace(trigger); trigger == "" {
+				continue
+			}
+			// 单调定时器的属性名为 OnBootUSec 等，换回单元文件中的写法
+			if name, setting, ok := strings.Cut(trigger, "="); ok {
+				trigger = strings.Replace(name, "USec", "Sec", 1) + "=" + setting
+			}
+			triggers = append(triggers, trigger)
+		}
+	}
+	return strings.Join(triggers, "; ")
+}
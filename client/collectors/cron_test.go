@@ -0,0 +1,133 @@
+package collectors
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"GoMonitor/proto"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+func TestParseCrontabLine(t *testing.T) {
+	tests := []struct {
+		name   string
+		line   string
+		system bool
+		want   *proto.ScheduledJob
+	}{
+		{"空行", "   ", false, nil},
+		{"注释", "# m h dom mon dow command", false, nil},
+		{"环境变量", "MAILTO=root", false, nil},
+		{"带空格的环境变量", "PATH = /usr/bin:/bin", true, nil},
+		{"用户crontab", "*/5 * * * * /usr/local/bin/backup.sh --quiet", false,
+			&proto.ScheduledJob{Schedule: "*/5 * * * *", Command: "/usr/local/bin/backup.sh --quiet"}},
+		{"系统crontab带用户字段", "17 *\t* * *\troot    cd / && run-parts --report /etc/cron.hourly", true,
+			&proto.ScheduledJob{Schedule: "17 * * * *", User: "root", Command: "cd / && run-parts --report /etc/cron.hourly"}},
+		{"命令保留内部空白", "0 3 * * 0 echo  'a   b'", false,
+			&proto.ScheduledJob{Schedule: "0 3 * * 0", Command: "echo  'a   b'"}},
+		{"@关键字", "@reboot /opt/app/start", false,
+			&proto.ScheduledJob{Schedule: "@reboot", Command: "/opt/app/start"}},
+		{"同义关键字统一", "@midnight www-data /usr/bin/php /var/www/cron.php", true,
+			&proto.ScheduledJob{Schedule: "@daily", User: "www-data", Command: "/usr/bin/php /var/www/cron.php"}},
+		{"@annually", "@annually /bin/true", false,
+			&proto.ScheduledJob{Schedule: "@yearly", Command: "/bin/true"}},
+		{"字段不足", "* * * * *", false, nil},
+		{"系统crontab缺少命令", "* * * * * root", true, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseCrontabLine(tt.line, tt.system)
+			if (got == nil) != (tt.want == nil) || (got != nil && !protobuf.Equal(got, tt.want)) {
+				t.Fatalf("parseCrontabLine(%q) = %v, 期望 %v", tt.line, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimerSchedule(t *testing.T) {
+	tests := []struct {
+		name  string
+		timer map[string][]string
+		want  string
+	}{
+		{"没有触发条件", map[string][]string{}, ""},
+		{
+			"日历定时器",
+			map[string][]string{"TimersCalendar": {"{ OnCalendar=*-*-* 06,18:00:00 ; next_elapse=Mon 2026-10-19 18:00:00 UTC }"}},
+			"OnCalendar=*-*-* 06,18:00:00",
+		},
+		{
+			"单调定时器换回单元文件的写法",
+			map[string][]string{"TimersMonotonic": {
+				"{ OnBootUSec=15min ; next_elapse=0 }",
+				"{ OnUnitActiveUSec=1d ; next_elapse=1d 15min }",
+			}},
+			"OnBootSec=15min; OnUnitActiveSec=1d",
+		},
+		{
+			"日历在前",
+			map[string][]string{
+				"TimersMonotonic": {"{ OnBootUSec=5min ; next_elapse=0 }"},
+				"TimersCalendar":  {"{ OnCalendar=daily ; next_elapse=n/a }", "{  }"},
+			},
+			"OnCalendar=daily; OnBootSec=5min",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := timerSchedule(tt.timer); got != tt.want {
+				t.Fatalf("timerSchedule = %q, 期望 %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCronCollector(t *testing.T) {
+	root := t.TempDir()
+	writeFixture(t, root, map[string]string{
+		"etc/crontab":                        "SHELL=/bin/sh\n17 * * * * root cd / && run-parts --report /etc/cron.hourly\n",
+		"etc/cron.d/certbot":                 "0 */12 * * * root certbot -q renew\n",
+		"etc/cron.d/old.dpkg-old":            "* * * * * root /bin/false\n",
+		"etc/cron.daily/logrotate":           "#!/bin/sh\n",
+		"etc/cron.daily/not-executable":      "#!/bin/sh\n",
+		"var/spool/cron/crontabs/alice":      "# DO NOT EDIT\n30 2 * * * /home/alice/sync\n",
+		"var/spool/cron/crontabs/tmp.X1y2Z3": "* * * * * /bin/false\n",
+	})
+	if err := os.Chmod(filepath.Join(root, "etc/cron.daily/logrotate"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	info := &proto.SystemInfo{}
+	if err := NewCronCollector(root).Collect(context.Background(), Options{}, info); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*proto.ScheduledJob{
+		{Source: "cron.d", File: filepath.Join(root, "etc/cron.d/certbot"), User: "root", Schedule: "0 */12 * * *", Command: "certbot -q renew"},
+		{Source: "cron.daily", File: filepath.Join(root, "etc/cron.daily/logrotate"), User: "root", Schedule: "@daily", Command: filepath.Join(root, "etc/cron.daily/logrotate")},
+		{Source: "crontab", File: filepath.Join(root, "etc/crontab"), User: "root", Schedule: "17 * * * *", Command: "cd / && run-parts --report /etc/cron.hourly"},
+		{Source: "user", File: filepath.Join(root, "var/spool/cron/crontabs/alice"), User: "alice", Schedule: "30 2 * * *", Command: "/home/alice/sync"},
+	}
+	jobs := info.ScheduledJobs.GetJobs()
+	if len(jobs) != len(want) {
+		t.Fatalf("得到 %d 个任务，期望 %d 个: %v", len(jobs), len(want), jobs)
+	}
+	for i := range want {
+		if !protobuf.Equal(jobs[i], want[i]) {
+			t.Errorf("第 %d 个任务 = %v, 期望 %v", i, jobs[i], want[i])
+		}
+	}
+
+	// 没有任何cron配置时不上报
+	info = &proto.SystemInfo{}
+	if err := NewCronCollector(t.TempDir()).Collect(context.Background(), Options{}, info); err != nil {
+		t.Fatal(err)
+	}
+	if info.ScheduledJobs != nil {
+		t.Fatalf("ScheduledJobs = %v", info.ScheduledJobs)
+	}
+}
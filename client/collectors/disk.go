@@ -1,13 +1,60 @@
 package collectors
 
 import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
 	"GoMonitor/proto"
 	"github.com/shirou/gopsutil/disk"
 )
 
+// 块设备计数器在rateTracker中的下标
+const (
+	diskReadBytes = iota
+	diskWriteBytes
+	diskReads
+	diskWrites
+	diskIOTimeMs // 读写请求的累计耗时（毫秒）
+	diskBusyMs   // 设备忙碌的累计时间（毫秒）
+)
+
+// DiskCollector 采集磁盘分区使用情况和各块设备的IO速率
+type DiskCollector struct {
+	rates *rateTracker
+}
+
+// NewDiskCollector 创建磁盘采集器，并以当前计数器作为第一个统计窗口的起点
+func NewDiskCollector() *DiskCollector {
+	c := &DiskCollector{
+		rates: newRateTracker(),
+	}
+	if ioStats, err := disk.IOCountersWithContext(context.Background()); err == nil {
+		c.rates.Update(context.Background(), diskCounters(ioStats), true)
+	}
+	return c
+}
+
+// Name 返回采集器名称
+func (c *DiskCollector) Name() string {
+	return "disk"
+}
+
+// Collect 实现Collector接口
+func (c *DiskCollector) Collect(ctx context.Context, opts Options, info *proto.SystemInfo) error {
+	diskInfo, err := c.CollectDiskInfo(ctx, opts)
+	if err != nil {
+		return err
+	}
+	info.DiskInfo = diskInfo
+	return nil
+}
+
 // CollectDiskInfo 收集磁盘信息
-func CollectDiskInfo() (*proto.DiskInfo, error) {
-	partitions, err := disk.Partitions(false)
+func (c *DiskCollector) CollectDiskInfo(ctx context.Context, opts Options) (*proto.DiskInfo, error) {
+	partitions, err := disk.PartitionsWithContext(ctx, false)
 	if err != nil {
 		return nil, err
 	}
@@ -18,8 +65,12 @@ func CollectDiskInfo() (*proto.DiskInfo, error) {
 		DiskWrites: 0,
 	}
 
-	for _, partition := range partitions {
-		usage, err := disk.Usage(partition.Mountpoint)
+	for _, partition := range dedupeBindMounts(partitions) {
+		if !opts.includePartition(partition.Mountpoint, partition.Fstype, partition.Device) {
+			continue
+		}
+
+		usage, err := disk.UsageWithContext(ctx, partition.Mountpoint)
 		if err != nil {
 			continue
 		}
@@ -31,18 +82,123 @@ func CollectDiskInfo() (*proto.DiskInfo, error) {
 			UsedSpace:    int64(usage.Used),
 			FreeSpace:    int64(usage.Free),
 			UsagePercent: usage.UsedPercent,
+			Device:       partition.Device,
+		}
+
+		// 部分文件系统（例如btrfs、vfat）没有固定的inode数量，总数为0
+		if usage.InodesTotal > 0 {
+			diskPartition.InodesTotal = int64(usage.InodesTotal)
+			diskPartition.InodesUsed = int64(usage.InodesUsed)
+			diskPartition.InodesFree = int64(usage.InodesFree)
+			diskPartition.InodesUsagePercent = usage.InodesUsedPercent
 		}
 
 		diskInfo.Partitions = append(diskInfo.Partitions, diskPartition)
 	}
 
-	ioStats, err := disk.IOCounters()
+	ioStats, err := disk.IOCountersWithContext(ctx)
 	if err == nil {
 		for _, stat := range ioStats {
 			diskInfo.DiskReads += int64(stat.ReadCount)
 			diskInfo.DiskWrites += int64(stat.WriteCount)
 		}
+
+		rates, elapsed := c.rates.Update(ctx, diskCounters(ioStats), !opts.Peek)
+		diskInfo.Devices = diskDeviceStats(rates)
+		diskInfo.SampleSeconds = elapsed.Seconds()
 	}
 
 	return diskInfo, nil
 }
+
+// dedupeBindMounts 去除同一设备上重复挂载的分区（bind mount、容器挂载等），每个设备只保留挂载点最短的一个
+// 设备不是路径的文件系统（例如nfs的 host:/export）按原样保留，其余分区保持原有顺序
+func dedupeBindMounts(partitions []disk.PartitionStat) []disk.PartitionStat {
+	shortest := make(map[string]string)
+	for _, partition := range partitions {
+		if !strings.HasPrefix(partition.Device, "/") {
+			continue
+		}
+		key := partition.Device + "|" + partition.Fstype
+		if current, ok := shortest[key]; !ok || len(partition.Mountpoint) < len(current) {
+			shortest[key] = partition.Mountpoint
+		}
+	}
+
+	result := make([]disk.PartitionStat, 0, len(partitions))
+	for _, partition := range partitions {
+		if strings.HasPrefix(partition.Device, "/") {
+			key := partition.Device + "|" + partition.Fstype
+			if shortest[key] != partition.Mountpoint {
+				continue
+			}
+			// 同一挂载点被重复挂载时只保留一次
+			delete(shortest, key)
+		}
+		result = append(result, partition)
+	}
+	return result
+}
+
+// diskCounters 提取需要计算速率的块设备计数器
+// 只统计整块设备，分区、loop和ram设备会被跳过以免重复计算
+func diskCounters(ioStats map[string]disk.IOCountersStat) map[string][]uint64 {
+	counters := make(map[string][]uint64)
+	for name, stat := range ioStats {
+		if !isWholeDisk(name) {
+			continue
+		}
+		counters[name] = []uint64{
+			diskReadBytes:  stat.ReadBytes,
+			diskWriteBytes: stat.WriteBytes,
+			diskReads:      stat.ReadCount,
+			diskWrites:     stat.WriteCount,
+			diskIOTimeMs:   stat.ReadTime + stat.WriteTime,
+			diskBusyMs:     stat.IoTime,
+		}
+	}
+	return counters
+}
+
+// isWholeDisk 判断设备是否为整块磁盘；无法读取 /sys/block 时不做过滤
+func isWholeDisk(name string) bool {
+	if strings.HasPrefix(name, "loop") || strings.HasPrefix(name, "ram") {
+		return false
+	}
+	if _, err := os.Stat("/sys/block"); err != nil {
+		return true
+	}
+	_, err := os.Stat(filepath.Join("/sys/block", name))
+	return err == nil
+}
+
+// diskDeviceStats 把计数器增量换算为各设备的速率，按设备名排序
+func diskDeviceStats(rates map[string]counterRates) []*proto.DiskDeviceStats {
+	devices := make([]*proto.DiskDeviceStats, 0, len(rates))
+	for name, r := range rates {
+		device := &proto.DiskDeviceStats{
+			Name:             name,
+			ReadBytesPerSec:  r.perSecond(diskReadBytes),
+			WriteBytesPerSec: r.perSecond(diskWriteBytes),
+			ReadsPerSec:      r.perSecond(diskReads),
+			WritesPerSec:     r.perSecond(diskWrites),
+		}
+
+		if ops := r.deltas[diskReads] + r.deltas[diskWrites]; ops > 0 {
+			device.AwaitMs = float64(r.deltas[diskIOTimeMs]) / float64(ops)
+		}
+		if ms := float64(r.elapsed.Milliseconds()); ms > 0 {
+			device.UtilizationPercent = float64(r.deltas[diskBusyMs]) / ms * 100
+			if device.UtilizationPercent > 100 {
+				device.UtilizationPercent = 100
+			}
+		}
+
+		devices = append(devices, device)
+	}
+
+	sort.Slice(devices, func(i, j int) bool {
+		return devices[i].Name < devices[j].Name
+	})
+	return devices
+}
@@ -0,0 +1,81 @@
+package collectors
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// DefaultDockerSocket 是Docker Engine API的默认unix socket
+const DefaultDockerSocket = "/var/run/docker.sock"
+
+// dockerAPITimeout 是单次查询Docker Engine API的超时
+const dockerAPITimeout = 5 * time.Second
+
+// DockerClient 通过unix socket访问Docker Engine API
+type DockerClient struct {
+	socket string
+	client *http.Client
+}
+
+// NewDockerClient 创建Docker Engine API客户端
+func NewDockerClient(socket string) *DockerClient {
+	return &DockerClient{
+		socket: socket,
+		client: &http.Client{
+			Transport: &http.Transport{
+				DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+					var d net.Dialer
+					return d.DialContext(ctx, "unix", socket)
+				},
+			},
+			Timeout: dockerAPITimeout,
+		},
+	}
+}
+
+// Available 判断socket是否存在，未安装Docker的主机不视为错误
+func (d *DockerClient) Available() bool {
+	_, err := os.Stat(d.socket)
+	return err == nil
+}
+
+// ContainerNames 返回运行中容器的ID到名称的映射
+func (d *DockerClient) ContainerNames(ctx context.Context) (map[string]string, error) {
+	// 主机名部分会被忽略，请求实际通过unix socket发送
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://docker/containers/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("查询Docker容器失败: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("查询Docker容器失败: HTTP状态码 %d", resp.StatusCode)
+	}
+
+	var containers []struct {
+		ID    string   `json:"Id"`
+		Names []string `json:"Names"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&containers); err != nil {
+		return nil, fmt.Errorf("解析Docker容器列表失败: %w", err)
+	}
+
+	names := make(map[string]string, len(containers))
+	for _, container := range containers {
+		if len(container.Names) > 0 {
+			names[container.ID] = strings.TrimPrefix(container.Names[0], "/")
+		}
+	}
+	return names, nil
+}
@@ -0,0 +1,115 @@
+package collectors
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// startDockerServer 在临时unix socket上启动模拟的Docker Engine API
+func startDockerServer(t *testing.T, handler http.HandlerFunc) string {
+	t.Helper()
+	socket := filepath.Join(shortTempDir(t), "docker.sock")
+	listener, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Skipf("无法创建unix socket: %v", err)
+	}
+	server := httptest.NewUnstartedServer(handler)
+	server.Listener = listener
+	server.Start()
+	t.Cleanup(server.Close)
+	return socket
+}
+
+// shortTempDir 创建临时目录；unix socket路径长度有限，不使用t.TempDir()下较深的路径
+func shortTempDir(t *testing.T) string {
+	t.Helper()
+	dir, err := os.MkdirTemp("", "docker")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	return dir
+}
+
+func TestDockerContainerNames(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name:   "容器列表",
+			status: http.StatusOK,
+			body:   `[{"Id":"` + testContainerID + `","Names":["/web"]},{"Id":"abc","Names":[]}]`,
+			want:   map[string]string{testContainerID: "web"},
+		},
+		{name: "HTTP错误", status: http.StatusInternalServerError, body: `{"message":"boom"}`, wantErr: true},
+		{name: "无效JSON", status: http.StatusOK, body: `{`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			socket := startDockerServer(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/containers/json" {
+					http.NotFound(w, r)
+					return
+				}
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			})
+
+			d := NewDockerClient(socket)
+			if !d.Available() {
+				t.Fatal("socket不可用")
+			}
+			names, err := d.ContainerNames(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, 期望出错: %v", err, tt.wantErr)
+			}
+			if len(names) != len(tt.want) {
+				t.Fatalf("names = %v, 期望 %v", names, tt.want)
+			}
+			for id, name := range tt.want {
+				if names[id] != name {
+					t.Fatalf("names = %v, 期望 %v", names, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestDockerSocketErrors(t *testing.T) {
+	dir := t.TempDir()
+
+	missing := NewDockerClient(filepath.Join(dir, "missing.sock"))
+	if missing.Available() {
+		t.Fatal("不存在的socket被视为可用")
+	}
+	if _, err := missing.ContainerNames(context.Background()); err == nil {
+		t.Fatal("连接不存在的socket未返回错误")
+	}
+
+	// socket文件存在但没有进程监听
+	stale := filepath.Join(shortTempDir(t), "stale.sock")
+	l, err := net.Listen("unix", stale)
+	if err != nil {
+		t.Skipf("无法创建unix socket: %v", err)
+	}
+	l.(*net.UnixListener).SetUnlinkOnClose(false)
+	l.Close()
+
+	d := NewDockerClient(stale)
+	if !d.Available() {
+		t.Fatal("socket文件应存在")
+	}
+	if _, err := d.ContainerNames(context.Background()); err == nil {
+		t.Fatal("连接无人监听的socket未返回错误")
+	}
+}
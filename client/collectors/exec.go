@@ -0,0 +1,129 @@
+package collectors
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxExecOutput 限制外部程序输出的最大字节数，防止异常插件占用过多内存
+const maxExecOutput = 1 << 20
+
+// execWaitDelay 是外部程序退出或被终止后等待其输出管道关闭的最长时间
+// 程序派生的子进程继承了输出管道并继续运行时，超过该时间不再等待
+const execWaitDelay = 2 * time.Second
+
+// execResult 外部程序的执行结果
+type execResult struct {
+	Stdout   []byte
+	Stderr   string
+	ExitCode int
+}
+
+// limitedBuffer 超过上限后丢弃多余输出
+type limitedBuffer struct {
+	buf       bytes.Buffer
+	truncated bool
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if remaining := maxExecOutput - b.buf.Len(); remaining < len(p) {
+		b.truncated = true
+		if remaining > 0 {
+			b.buf.Write(p[:remaining])
+		}
+		return len(p), nil
+	}
+	return b.buf.Write(p)
+}
+
+// runExternal 在超时控制下运行外部程序
+// 程序正常退出（包括非零退出码）时返回nil错误，调用方根据ExitCode自行判断；超时或无法启动时返回错误
+// 超时时终止程序所在的整个进程组（支持的系统上），包括它派生的子进程
+func runExternal(ctx context.Context, timeout time.Duration, command string, args []string) (*execResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var stdout, stderr limitedBuffer
+	cmd := exec.CommandContext(ctx, command, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	cmd.WaitDelay = execWaitDelay
+	setProcessGroup(cmd)
+
+	err := cmd.Run()
+	if ctx.Err() != nil {
+		return nil, fmt.Errorf("执行超时（%v）", timeout)
+	}
+	if errors.Is(err, exec.ErrWaitDelay) {
+		// 程序本身已成功退出，只是遗留的子进程仍占用输出管道，已读到的输出即为程序的输出
+		err = nil
+	}
+
+	result := &execResult{
+		Stdout: stdout.buf.Bytes(),
+		Stderr: strings.TrimSpace(stderr.buf.String()),
+	}
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		result.ExitCode = exitErr.ExitCode()
+		return result, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("启动失败: %w", err)
+	}
+
+	if stdout.truncated {
+		return nil, fmt.Errorf("输出超过 %d 字节", maxExecOutput)
+	}
+	return result, nil
+}
+
+// periodicRunner 为每个任务启动独立的goroutine按固定间隔运行
+type periodicRunner struct {
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// startPeriodic 立即运行一次task，之后每隔interval运行一次，直到runner被停止
+func (r *periodicRunner) startPeriodic(ctx context.Context, interval time.Duration, task func(ctx context.Context)) {
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+
+		task(ctx)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				task(ctx)
+			}
+		}
+	}()
+}
+
+// newPeriodicRunner 创建runner，返回用于启动任务的上下文
+func newPeriodicRunner() (*periodicRunner, context.Context) {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &periodicRunner{cancel: cancel}, ctx
+}
+
+// stop 停止所有任务并等待其退出
+func (r *periodicRunner) stop() {
+	if r == nil {
+		return
+	}
+	r.cancel()
+	r.wg.Wait()
+}
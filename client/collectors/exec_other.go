@@ -0,0 +1,8 @@
+//go:build !unix
+
+package collectors
+
+import "os/exec"
+
+// setProcessGroup 在不支持进程组的系统上只终止外部程序本身
+func setProcessGroup(cmd *exec.Cmd) {}
@@ -0,0 +1,136 @@
+package collectors
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"GoMonitor/proto"
+)
+
+// PluginSpec 描述一个外部指标插件
+type PluginSpec struct {
+	Name     string
+	Command  string
+	Args     []string
+	Interval time.Duration
+	Timeout  time.Duration
+	Format   string // keyvalue、json 或 prometheus
+}
+
+// pluginState 插件最近一次运行的结果
+type pluginState struct {
+	metrics   map[string]string
+	err       error
+	updatedAt time.Time
+}
+
+// ExecPluginCollector 按各自的间隔在后台运行外部插件，并把解析出的指标放入custom_metrics
+// 指标名格式为 "<插件名>.<指标名>"，单个插件失败只会在collector_errors中记录，不影响其他插件
+type ExecPluginCollector struct {
+	mu     sync.Mutex
+	specs  []PluginSpec
+	states map[string]*pluginState
+	runner *periodicRunner
+}
+
+// NewExecPluginCollector 创建插件采集器
+func NewExecPluginCollector() *ExecPluginCollector {
+	return &ExecPluginCollector{
+		states: make(map[string]*pluginState),
+	}
+}
+
+// Name 返回采集器名称
+func (c *ExecPluginCollector) Name() string {
+	return "plugins"
+}
+
+// SetPlugins 替换插件列表并重新启动后台任务
+func (c *ExecPluginCollector) SetPlugins(specs []PluginSpec) {
+	c.mu.Lock()
+	old := c.runner
+	c.runner = nil
+	c.mu.Unlock()
+
+	old.stop()
+
+	runner, ctx := newPeriodicRunner()
+
+	c.mu.Lock()
+	c.specs = specs
+	c.states = make(map[string]*pluginState)
+	c.runner = runner
+	c.mu.Unlock()
+
+	for _, spec := range specs {
+		spec := spec
+		runner.startPeriodic(ctx, spec.Interval, func(ctx context.Context) {
+			c.runPlugin(ctx, spec)
+		})
+	}
+}
+
+// Stop 停止所有插件任务
+func (c *ExecPluginCollector) Stop() {
+	c.mu.Lock()
+	runner := c.runner
+	c.runner = nil
+	c.mu.Unlock()
+
+	runner.stop()
+}
+
+// runPlugin 运行一次插件并保存结果
+func (c *ExecPluginCollector) runPlugin(ctx context.Context, spec PluginSpec) {
+	state := &pluginState{updatedAt: time.Now()}
+
+	result, err := runExternal(ctx, spec.Timeout, spec.Command, spec.Args)
+	switch {
+	case ctx.Err() != nil:
+		// 插件列表已被替换，丢弃结果
+		return
+	case err != nil:
+		state.err = err
+	case result.ExitCode != 0:
+		state.err = fmt.Errorf("退出码 %d: %s", result.ExitCode, result.Stderr)
+	default:
+		state.metrics, state.err = ParseMetrics(spec.Format, result.Stdout)
+	}
+
+	c.mu.Lock()
+	c.states[spec.Name] = state
+	c.mu.Unlock()
+}
+
+// Collect 实现Collector接口，返回各插件最近一次的结果
+func (c *ExecPluginCollector) Collect(ctx context.Context, opts Options, info *proto.SystemInfo) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, spec := range c.specs {
+		state, ok := c.states[spec.Name]
+		if !ok {
+			continue
+		}
+
+		if state.err != nil {
+			info.CollectorErrors = append(info.CollectorErrors, &proto.CollectorError{
+				Collector: "plugin:" + spec.Name,
+				Error:     state.err.Error(),
+				Timestamp: state.updatedAt.Unix(),
+			})
+			continue
+		}
+
+		if info.CustomMetrics == nil {
+			info.CustomMetrics = make(map[string]string)
+		}
+		for name, value := range state.metrics {
+			info.CustomMetrics[spec.Name+"."+name] = value
+		}
+	}
+
+	return nil
+}
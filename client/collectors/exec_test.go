@@ -0,0 +1,52 @@
+//go:build unix
+
+package collectors
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRunExternal(t *testing.T) {
+	tests := []struct {
+		name     string
+		script   string
+		timeout  time.Duration
+		stdout   string
+		exitCode int
+		wantErr  string
+	}{
+		{"正常退出", "echo ok", 5 * time.Second, "ok\n", 0, ""},
+		{"非零退出码", "echo warn; exit 1", 5 * time.Second, "warn\n", 1, ""},
+		{"超时", "sleep 30", 200 * time.Millisecond, "", 0, "执行超时"},
+		// 子进程继承输出管道，超时后整个进程组被终止，不会等到子进程退出
+		{"超时时子进程占用输出", "sleep 30 & echo started; sleep 30", 200 * time.Millisecond, "", 0, "执行超时"},
+		// 程序已退出，遗留的子进程仍占用输出管道
+		{"遗留子进程占用输出", "sleep 5 & echo done", 5 * time.Second, "done\n", 0, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := time.Now()
+			result, err := runExternal(context.Background(), tt.timeout, "sh", []string{"-c", tt.script})
+			if elapsed := time.Since(start); elapsed > tt.timeout+execWaitDelay+time.Second {
+				t.Fatalf("耗时 %v，超过超时加等待时间", elapsed)
+			}
+
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("错误 = %v, 期望包含 %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(result.Stdout) != tt.stdout || result.ExitCode != tt.exitCode {
+				t.Fatalf("输出 %q 退出码 %d, 期望 %q 和 %d", result.Stdout, result.ExitCode, tt.stdout, tt.exitCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+//go:build unix
+
+package collectors
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// setProcessGroup 让外部程序在新的进程组中运行，取消时向整个进程组发送SIGKILL
+func setProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = func() error {
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+}
@@ -0,0 +1,22 @@
+package collectors
+
+import (
+	"io/fs"
+	"syscall"
+)
+
+// fileOwner 返回文件的属主和属组
+func fileOwner(info fs.FileInfo) (uint32, uint32) {
+	if stat, ok := info.Sys().(*syscall.Stat_t); ok {
+		return stat.Uid, stat.Gid
+	}
+	return 0, 0
+}
+
+// fileInode 返回文件的inode号，用于识别日志轮转
+func fileInode(info fs.FileInfo) uint64 {
+	if stat, ok := info.Sys().(*syscall.Stat_t); ok {
+		return stat.Ino
+	}
+	return 0
+}
@@ -0,0 +1,15 @@
+//go:build !linux
+
+package collectors
+
+import "io/fs"
+
+// fileOwner 在不支持的系统上不记录属主
+func fileOwner(info fs.FileInfo) (uint32, uint32) {
+	return 0, 0
+}
+
+// fileInode 在不支持的系统上返回0，日志轮转只能通过文件变小发现
+func fileInode(info fs.FileInfo) uint64 {
+	return 0
+}
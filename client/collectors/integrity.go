@@ -0,0 +1,424 @@
+package collectors
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"GoMonitor/pkg/models"
+	"GoMonitor/pkg/utils"
+	"GoMonitor/proto"
+)
+
+// inotifyDebounce 是收到inotify事件后等待文件写完的时间，期间的后续事件合并为一次扫描
+const inotifyDebounce = 2 * time.Second
+
+// IntegritySpec 文件完整性监控的运行参数
+type IntegritySpec struct {
+	Paths        []string // 监控的文件或目录，支持通配符，目录递归监控
+	Exclude      []string // 排除的路径，通配符或 "re:" 开头的正则表达式
+	BaselineFile string
+	Inotify      bool
+}
+
+// integrityBaseline 是保存在磁盘上的基线，Paths和Exclude记录建立基线时的监控范围
+type integrityBaseline struct {
+	CreatedAt int64                       `json:"created_at"`
+	Paths     []string                    `json:"paths"`
+	Exclude   []string                    `json:"exclude"`
+	Files     map[string]*proto.FileState `json:"files"`
+}
+
+// IntegrityCollector 对比监控范围内文件的哈希、权限和属主与基线，上报新增、修改和删除的文件
+// 基线在首次采集时建立并保存到磁盘，之后只有调用Rebaseline才会更新；监控范围变化时，新纳入的文件直接计入基线
+// 开启inotify时，监控的目录发生变化后立即重新扫描并通过onChange通知客户端上报
+type IntegrityCollector struct {
+	mu       sync.Mutex
+	spec     IntegritySpec
+	baseline *integrityBaseline // nil表示尚未从磁盘加载
+	watcher  *inotifyWatcher
+	onChange func()
+}
+
+// NewIntegrityCollector 创建文件完整性采集器，onChange在inotify发现变化时调用
+func NewIntegrityCollector(spec IntegritySpec, onChange func()) *IntegrityCollector {
+	return &IntegrityCollector{
+		spec:     spec,
+		onChange: onChange,
+	}
+}
+
+// Name 返回采集器名称
+func (c *IntegrityCollector) Name() string {
+	return "integrity"
+}
+
+// SetSpec 更新监控范围，基线文件位置变化时从新位置重新加载
+func (c *IntegrityCollector) SetSpec(spec IntegritySpec) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if spec.BaselineFile != c.spec.BaselineFile {
+		c.baseline = nil
+	}
+	c.spec = spec
+	if !spec.Inotify || len(spec.Paths) == 0 {
+		c.stopWatcher()
+	}
+}
+
+// Stop 停止inotify监听
+func (c *IntegrityCollector) Stop() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.stopWatcher()
+}
+
+// Collect 实现Collector接口，没有配置监控路径时不上报
+func (c *IntegrityCollector) Collect(ctx context.Context, opts Options, info *proto.SystemInfo) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.spec.Paths) == 0 {
+		return nil
+	}
+
+	current, dirs, err := scanFiles(ctx, c.spec.Paths, c.spec.Exclude)
+	if err != nil {
+		return err
+	}
+	if err := c.loadBaseline(current); err != nil {
+		return err
+	}
+	if c.spec.Inotify {
+		c.updateWatcher(dirs)
+	}
+
+	info.Integrity = &proto.IntegrityReport{
+		BaselinedAt: c.baseline.CreatedAt,
+		FileCount:   int32(len(c.baseline.Files)),
+		Changes:     diffFileStates(c.baseline.Files, current),
+	}
+	return nil
+}
+
+// Rebaseline 以当前文件状态重建基线，返回被接受的变化数量
+// pattern为空时重建整个基线；否则只接受路径匹配pattern（或位于匹配的目录下）的变化
+func (c *IntegrityCollector) Rebaseline(ctx context.Context, pattern string) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.spec.Paths) == 0 {
+		return 0, errors.New("未配置 integrity.paths")
+	}
+	if _, err := filepath.Match(pattern, ""); err != nil {
+		return 0, fmt.Errorf("无效的通配符 %q: %w", pattern, err)
+	}
+
+	current, _, err := scanFiles(ctx, c.spec.Paths, c.spec.Exclude)
+	if err != nil {
+		return 0, err
+	}
+	if err := c.loadBaseline(current); err != nil && pattern != "" {
+		// 基线损坏时只能整体重建
+		return 0, err
+	}
+
+	if pattern == "" {
+		changes := 0
+		if c.baseline != nil {
+			changes = len(diffFileStates(c.baseline.Files, current))
+		}
+		c.baseline = c.newBaseline(current)
+		return changes, c.saveBaseline()
+	}
+
+	accepted := 0
+	for _, change := range diffFileStates(c.baseline.Files, current) {
+		if !underPatterns(change.Path, []string{pattern}) {
+			continue
+		}
+		if change.After == nil {
+			delete(c.baseline.Files, change.Path)
+		} else {
+			c.baseline.Files[change.Path] = change.After
+		}
+		accepted++
+	}
+	c.baseline.CreatedAt = time.Now().Unix()
+	return accepted, c.saveBaseline()
+}
+
+// newBaseline 以当前监控范围和文件状态创建基线
+func (c *IntegrityCollector) newBaseline(files map[string]*proto.FileState) *integrityBaseline {
+	return &integrityBaseline{
+		CreatedAt: time.Now().Unix(),
+		Paths:     slices.Clone(c.spec.Paths),
+		Exclude:   slices.Clone(c.spec.Exclude),
+		Files:     files,
+	}
+}
+
+// loadBaseline 确保基线已加载：基线文件不存在时以当前状态建立，监控范围变化时调整基线
+// 基线文件无法解析时返回错误而不是重建，以免掩盖对基线的篡改
+func (c *IntegrityCollector) loadBaseline(current map[string]*proto.FileState) error {
+	if c.baseline == nil {
+		data, err := os.ReadFile(c.spec.BaselineFile)
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			c.baseline = c.newBaseline(current)
+			log.Printf("已建立文件完整性基线，共 %d 个文件", len(current))
+			return c.saveBaseline()
+		case err != nil:
+			return fmt.Errorf("读取基线失败: %w", err)
+		}
+
+		var baseline integrityBaseline
+		if err := json.Unmarshal(data, &baseline); err != nil {
+			return fmt.Errorf("基线 %s 已损坏，需要重建: %w", c.spec.BaselineFile, err)
+		}
+		if baseline.Files == nil {
+			baseline.Files = make(map[string]*proto.FileState)
+		}
+		c.baseline = &baseline
+	}
+
+	if slices.Equal(c.baseline.Paths, c.spec.Paths) && slices.Equal(c.baseline.Exclude, c.spec.Exclude) {
+		return nil
+	}
+
+	oldFilter, err := utils.NewMatcher(nil, c.baseline.Exclude)
+	if err != nil {
+		return err
+	}
+	newFilter, err := utils.NewMatcher(nil, c.spec.Exclude)
+	if err != nil {
+		return err
+	}
+	for path, state := range current {
+		if _, ok := c.baseline.Files[path]; !ok && !(oldFilter.Match(path) && underPatterns(path, c.baseline.Paths)) {
+			c.baseline.Files[path] = state
+		}
+	}
+	for path := range c.baseline.Files {
+		if !newFilter.Match(path) || !underPatterns(path, c.spec.Paths) {
+			delete(c.baseline.Files, path)
+		}
+	}
+	c.baseline.Paths = slices.Clone(c.spec.Paths)
+	c.baseline.Exclude = slices.Clone(c.spec.Exclude)
+	log.Printf("文件完整性监控范围已变更，基线调整为 %d 个文件", len(c.baseline.Files))
+	return c.saveBaseline()
+}
+
+// saveBaseline 将基线写入临时文件后重命名，避免写到一半时留下损坏的基线
+func (c *IntegrityCollector) saveBaseline() error {
+	data, err := json.Marshal(c.baseline)
+	if err != nil {
+		return err
+	}
+
+	path := c.spec.BaselineFile
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("保存基线失败: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("保存基线失败: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("保存基线失败: %w", err)
+	}
+	return nil
+}
+
+// updateWatcher 启动inotify监听（如未启动）并更新监听的目录，失败时退回定期扫描
+func (c *IntegrityCollector) updateWatcher(dirs []string) {
+	if c.watcher == nil {
+		watcher, err := newInotifyWatcher(inotifyDebounce, c.onChange)
+		if err != nil {
+			log.Printf("启动inotify失败，仅定期扫描: %v", err)
+			c.spec.Inotify = false
+			return
+		}
+		c.watcher = watcher
+	}
+	c.watcher.watch(dirs)
+}
+
+// stopWatcher 停止inotify监听，调用方需持有c.mu
+func (c *IntegrityCollector) stopWatcher() {
+	if c.watcher != nil {
+		c.watcher.close()
+		c.watcher = nil
+	}
+}
+
+// scanFiles 扫描监控范围内的普通文件和符号链接，返回各文件的状态以及需要inotify监听的目录
+// 目录递归遍历但不跟随符号链接；通配符没有匹配到任何文件不是错误，之后新出现的文件会被报告为新增
+func scanFiles(ctx context.Context, patterns []string, exclude []string) (map[string]*proto.FileState, []string, error) {
+	filter, err := utils.NewMatcher(nil, exclude)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	files := make(map[string]*proto.FileState)
+	dirs := make(map[string]bool)
+
+	for _, pattern := range patterns {
+		dirs[globBase(pattern)] = true
+
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, root := range matches {
+			err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+				if ctxErr := ctx.Err(); ctxErr != nil {
+					return ctxErr
+				}
+				if err != nil {
+					// 遍历过程中被删除的文件按删除处理
+					if errors.Is(err, fs.ErrNotExist) {
+						return nil
+					}
+					return err
+				}
+				if !filter.Match(path) {
+					if d.IsDir() {
+						return filepath.SkipDir
+					}
+					return nil
+				}
+
+				if d.IsDir() {
+					dirs[path] = true
+					return nil
+				}
+				if !d.Type().IsRegular() && d.Type()&fs.ModeSymlink == 0 {
+					return nil
+				}
+
+				state, err := readFileState(path)
+				if errors.Is(err, fs.ErrNotExist) {
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				files[path] = state
+				dirs[filepath.Dir(path)] = true
+				return nil
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	dirList := make([]string, 0, len(dirs))
+	for dir := range dirs {
+		dirList = append(dirList, dir)
+	}
+	sort.Strings(dirList)
+	return files, dirList, nil
+}
+
+// readFileState 读取文件的属性并计算内容哈希，内容无法读取时记录在Error中而不是返回错误
+func readFileState(path string) (*proto.FileState, error) {
+	info, err := os.Lstat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &proto.FileState{
+		Mode:    uint32(info.Mode()),
+		Size:    info.Size(),
+		ModTime: info.ModTime().Unix(),
+	}
+	state.Uid, state.Gid = fileOwner(info)
+
+	if info.Mode()&fs.ModeSymlink != 0 {
+		state.LinkTarget, err = os.Readlink(path)
+		if err != nil {
+			state.Error = err.Error()
+		}
+		return state, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		state.Error = err.Error()
+		return state, nil
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		state.Error = err.Error()
+		return state, nil
+	}
+	state.Sha256 = hex.EncodeToString(hash.Sum(nil))
+	return state, nil
+}
+
+// diffFileStates 返回current相对于baseline的变化，按路径排序
+func diffFileStates(baseline, current map[string]*proto.FileState) []*proto.FileChange {
+	var changes []*proto.FileChange
+	for path, after := range current {
+		before, ok := baseline[path]
+		switch {
+		case !ok:
+			changes = append(changes, &proto.FileChange{Path: path, Action: models.FileAdded, After: after})
+		case !models.SameFileState(before, after):
+			changes = append(changes, &proto.FileChange{Path: path, Action: models.FileModified, Before: before, After: after})
+		}
+	}
+	for path, before := range baseline {
+		if _, ok := current[path]; !ok {
+			changes = append(changes, &proto.FileChange{Path: path, Action: models.FileDeleted, Before: before})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+	return changes
+}
+
+// underPatterns 判断路径是否匹配任一模式，或位于匹配的目录下
+func underPatterns(path string, patterns []string) bool {
+	for dir := path; ; dir = filepath.Dir(dir) {
+		for _, pattern := range patterns {
+			if matched, _ := filepath.Match(pattern, dir); matched {
+				return true
+			}
+		}
+		if parent := filepath.Dir(dir); parent == dir {
+			return false
+		}
+	}
+}
+
+// globBase 返回模式中第一个通配符之前的目录，例如 "/opt/app/*/bin" 返回 "/opt/app"
+// 不含通配符时返回所在目录，以便发现文件被删除后重新创建
+func globBase(pattern string) string {
+	idx := strings.IndexAny(pattern, "*?[\\")
+	if idx < 0 {
+		return filepath.Dir(pattern)
+	}
+	return filepath.Dir(pattern[:idx+1])
+}
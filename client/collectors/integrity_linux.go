@@ -0,0 +1,126 @@
+package collectors
+
+import (
+	"errors"
+	"log"
+	"os"
+	"sync"
+	"syscall"
+	"time"
+	"unsafe"
+)
+
+// inotifyMask 是监听目录时关心的事件：目录内文件的创建、删除、移动、内容和属性变化
+const inotifyMask = syscall.IN_CREATE | syscall.IN_DELETE | syscall.IN_MOVED_FROM | syscall.IN_MOVED_TO |
+	syscall.IN_CLOSE_WRITE | syscall.IN_MODIFY | syscall.IN_ATTRIB | syscall.IN_DELETE_SELF | syscall.IN_MOVE_SELF
+
+// inotifyWatcher 监听一组目录，目录内有变化且安静debounce之后调用onChange
+type inotifyWatcher struct {
+	file     *os.File
+	fd       int
+	mu       sync.Mutex
+	watches  map[string]int // 目录 -> watch descriptor
+	debounce time.Duration
+	onChange func()
+}
+
+// newInotifyWatcher 创建inotify实例并开始读取事件
+func newInotifyWatcher(debounce time.Duration, onChange func()) (*inotifyWatcher, error) {
+	fd, err := syscall.InotifyInit1(syscall.IN_CLOEXEC | syscall.IN_NONBLOCK)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &inotifyWatcher{
+		// 非阻塞的fd由运行时的poller管理，Close可以中断正在进行的Read
+		file:     os.NewFile(uintptr(fd), "inotify"),
+		fd:       fd,
+		watches:  make(map[string]int),
+		debounce: debounce,
+		onChange: onChange,
+	}
+	go w.run()
+	return w, nil
+}
+
+// watch 使监听的目录与dirs一致
+func (w *inotifyWatcher) watch(dirs []string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	wanted := make(map[string]bool, len(dirs))
+	for _, dir := range dirs {
+		wanted[dir] = true
+		if _, ok := w.watches[dir]; ok {
+			continue
+		}
+		wd, err := syscall.InotifyAddWatch(w.fd, dir, inotifyMask)
+		if err != nil {
+			// 目录可能尚不存在，下一次扫描时重试
+			continue
+		}
+		w.watches[dir] = wd
+	}
+
+	for dir, wd := range w.watches {
+		if !wanted[dir] {
+			syscall.InotifyRmWatch(w.fd, uint32(wd))
+			delete(w.watches, dir)
+		}
+	}
+}
+
+// close 停止监听
+func (w *inotifyWatcher) close() {
+	w.file.Close()
+}
+
+// run 读取事件，直到inotify实例被关闭
+// 事件内容不需要解析：任何变化都会触发一次完整扫描，由扫描结果决定上报什么
+func (w *inotifyWatcher) run() {
+	buf := make([]byte, 64*1024)
+	var timer *time.Timer
+
+	for {
+		n, err := w.file.Read(buf)
+		if err != nil {
+			if timer != nil {
+				timer.Stop()
+			}
+			if !errors.Is(err, os.ErrClosed) {
+				log.Printf("读取inotify事件失败: %v", err)
+			}
+			return
+		}
+		if n < syscall.SizeofInotifyEvent {
+			continue
+		}
+
+		// 被删除或移走的目录的watch会失效，清除记录以便重新添加
+		w.dropRemovedWatches(buf[:n])
+
+		if timer == nil {
+			timer = time.AfterFunc(w.debounce, w.onChange)
+		} else {
+			timer.Reset(w.debounce)
+		}
+	}
+}
+
+// dropRemovedWatches 处理IN_IGNORED事件，移除已失效的watch
+func (w *inotifyWatcher) dropRemovedWatches(buf []byte) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for offset := 0; offset+syscall.SizeofInotifyEvent <= len(buf); {
+		event := (*syscall.InotifyEvent)(unsafe.Pointer(&buf[offset]))
+		if event.Mask&syscall.IN_IGNORED != 0 {
+			for dir, wd := range w.watches {
+				if int32(wd) == event.Wd {
+					delete(w.watches, dir)
+				}
+			}
+		}
+		offset += syscall.SizeofInotifyEvent + int(event.Len)
+	}
+}
@@ -0,0 +1,19 @@
+//go:build !linux
+
+package collectors
+
+import (
+	"errors"
+	"time"
+)
+
+// inotifyWatcher 仅在Linux上可用
+type inotifyWatcher struct{}
+
+func newInotifyWatcher(debounce time.Duration, onChange func()) (*inotifyWatcher, error) {
+	return nil, errors.New("当前系统不支持inotify")
+}
+
+func (w *inotifyWatcher) watch(dirs []string) {}
+
+func (w *inotifyWatcher) close() {}
@@ -0,0 +1,152 @@
+package collectors
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"GoMonitor/proto"
+	"github.com/shirou/gopsutil/host"
+	"github.com/shirou/gopsutil/mem"
+)
+
+// DefaultSysRoot 是sysfs的默认挂载点
+const DefaultSysRoot = "/sys"
+
+// sectorSize 是 /sys/block/<dev>/size 使用的扇区大小，与设备的实际扇区大小无关
+const sectorSize = 512
+
+// CollectInventory 收集主机的硬件和操作系统清单，sysRoot为空时使用DefaultSysRoot
+// 清单中不含随时间变化的数值，两次收集的结果可以直接比较以判断硬件或系统是否发生变化
+// 无法获取的项留空，不视为错误
+func CollectInventory(ctx context.Context, sysRoot string) *proto.HostInventory {
+	if sysRoot == "" {
+		sysRoot = DefaultSysRoot
+	}
+
+	inv := &proto.HostInventory{}
+
+	if hostInfo, err := host.InfoWithContext(ctx); err == nil {
+		inv.KernelVersion = hostInfo.KernelVersion
+		inv.Distro = hostInfo.Platform
+		inv.DistroVersion = hostInfo.PlatformVersion
+		inv.Architecture = hostInfo.KernelArch
+		inv.Virtualization = hostInfo.VirtualizationSystem
+		inv.VirtualizationRole = hostInfo.VirtualizationRole
+		inv.BootTime = int64(hostInfo.BootTime)
+	}
+
+	topology := readCPUTopology(ctx)
+	inv.CpuModel = topology.modelName
+	inv.CpuSockets = int32(topology.sockets)
+	inv.CpuCores = int32(topology.physicalCores)
+	inv.CpuThreads = int32(topology.logicalCores)
+
+	if vm, err := mem.VirtualMemoryWithContext(ctx); err == nil {
+		inv.TotalMemory = int64(vm.Total)
+	}
+
+	inv.Disks = inventoryDisks(sysRoot)
+	inv.Nics = inventoryNICs(sysRoot)
+	inv.Dmi = inventoryDMI(sysRoot)
+
+	return inv
+}
+
+// inventoryDisks 列出 /sys/block 下有对应硬件设备的磁盘，loop、device-mapper、md等虚拟块设备没有device链接，会被跳过
+func inventoryDisks(sysRoot string) []*proto.InventoryDisk {
+	blockDir := filepath.Join(sysRoot, "block")
+	entries, err := os.ReadDir(blockDir)
+	if err != nil {
+		return nil
+	}
+
+	var disks []*proto.InventoryDisk
+	for _, entry := range entries {
+		dir := filepath.Join(blockDir, entry.Name())
+		if _, err := os.Stat(filepath.Join(dir, "device")); err != nil {
+			continue
+		}
+
+		sectors, _ := strconv.ParseInt(readStringFile(filepath.Join(dir, "size")), 10, 64)
+		disks = append(disks, &proto.InventoryDisk{
+			Name:       entry.Name(),
+			SizeBytes:  sectors * sectorSize,
+			Model:      readStringFile(filepath.Join(dir, "device", "model")),
+			Serial:     readStringFile(filepath.Join(dir, "device", "serial")),
+			Rotational: readStringFile(filepath.Join(dir, "queue", "rotational")) == "1",
+			Removable:  readStringFile(filepath.Join(dir, "removable")) == "1",
+		})
+	}
+
+	sort.Slice(disks, func(i, j int) bool {
+		return disks[i].Name < disks[j].Name
+	})
+	return disks
+}
+
+// inventoryNICs 列出 /sys/class/net 下有对应硬件设备的网卡，veth、网桥等虚拟接口会被跳过
+func inventoryNICs(sysRoot string) []*proto.InventoryNIC {
+	netDir := filepath.Join(sysRoot, "class", "net")
+	entries, err := os.ReadDir(netDir)
+	if err != nil {
+		return nil
+	}
+
+	var nics []*proto.InventoryNIC
+	for _, entry := range entries {
+		dir := filepath.Join(netDir, entry.Name())
+		if _, err := os.Stat(filepath.Join(dir, "device")); err != nil {
+			continue
+		}
+
+		mtu, _ := strconv.ParseInt(readStringFile(filepath.Join(dir, "mtu")), 10, 32)
+		// 链路断开时读取speed会返回错误或-1
+		speed, _ := strconv.ParseInt(readStringFile(filepath.Join(dir, "speed")), 10, 32)
+		if speed < 0 {
+			speed = 0
+		}
+
+		var driver string
+		if target, err := os.Readlink(filepath.Join(dir, "device", "driver")); err == nil {
+			driver = filepath.Base(target)
+		}
+
+		nics = append(nics, &proto.InventoryNIC{
+			Name:       entry.Name(),
+			MacAddress: readStringFile(filepath.Join(dir, "address")),
+			Mtu:        int32(mtu),
+			SpeedMbps:  int32(speed),
+			Driver:     driver,
+		})
+	}
+
+	sort.Slice(nics, func(i, j int) bool {
+		return nics[i].Name < nics[j].Name
+	})
+	return nics
+}
+
+// inventoryDMI 读取 /sys/class/dmi/id，没有DMI的平台（多数ARM设备）返回nil
+func inventoryDMI(sysRoot string) *proto.DMIInfo {
+	dir := filepath.Join(sysRoot, "class", "dmi", "id")
+	if _, err := os.Stat(dir); err != nil {
+		return nil
+	}
+
+	read := func(name string) string {
+		return readStringFile(filepath.Join(dir, name))
+	}
+	return &proto.DMIInfo{
+		Vendor:      read("sys_vendor"),
+		Product:     read("product_name"),
+		Serial:      read("product_serial"),
+		BoardVendor: read("board_vendor"),
+		BoardName:   read("board_name"),
+		BiosVendor:  read("bios_vendor"),
+		BiosVersion: read("bios_version"),
+		BiosDate:    read("bios_date"),
+	}
+}
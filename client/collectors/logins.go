@@ -0,0 +1,383 @@
+package collectors
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"GoMonitor/proto"
+)
+
+// 登录记录文件的位置
+const (
+	utmpPath = "/var/run/utmp"
+	wtmpPath = "/var/log/wtmp"
+	btmpPath = "/var/log/btmp"
+)
+
+// utmp记录类型
+const (
+	utmpRunLevel    = 1
+	utmpBootTime    = 2
+	utmpUserProcess = 7
+	utmpDeadProcess = 8
+)
+
+const (
+	// maxSSHLogins 是上报的成功SSH登录的最大条数（最新的）
+	maxSSHLogins = 50
+	// maxFailedLoginUsers 是每个来源最多列出的尝试过的用户名数
+	maxFailedLoginUsers = 10
+)
+
+var (
+	// sshdLinePattern 匹配sshd（OpenSSH 9.8起认证由sshd-session完成）写入的日志，取出消息部分
+	sshdLinePattern = regexp.MustCompile(`\bsshd(?:-session)?\[\d+\]: (.*)$`)
+	// repeatedPattern 匹配rsyslog对重复消息的合并
+	repeatedPattern = regexp.MustCompile(`^message repeated (\d+) times: \[ ?(.*?) ?\]$`)
+	acceptedPattern = regexp.MustCompile(`^Accepted (\S+) for (\S+) from (\S+) port \d+`)
+	failedPattern   = regexp.MustCompile(`^Failed (\S+) for (?:invalid user )?(\S*) from (\S+) port \d+`)
+)
+
+// utmpRecord 是utmp、wtmp和btmp中的一条记录
+type utmpRecord struct {
+	Type int16
+	PID  int32
+	Line string
+	User string
+	Host string
+	Time time.Time
+}
+
+// LoginSpec 描述登录审计采集器的配置
+type LoginSpec struct {
+	AuthLogs []string
+	Window   time.Duration
+	History  int
+}
+
+// authEvent 是认证日志中的一次SSH认证结果
+type authEvent struct {
+	time    time.Time
+	success bool
+	user    string
+	source  string
+	method  string
+	count   int // 被rsyslog合并的重复次数
+}
+
+// authLogState 是一个认证日志文件的增量读取状态
+type authLogState struct {
+	inode  uint64
+	offset int64
+}
+
+// LoginCollector 上报当前登录会话、最近的登录记录，以及统计窗口内成功的SSH登录和按来源统计的失败登录
+// 认证日志增量读取，窗口内的认证结果保存在内存中；日志轮转后从新文件开头继续
+type LoginCollector struct {
+	mu     sync.Mutex
+	spec   LoginSpec
+	logs   map[string]*authLogState // 认证日志路径 -> 读取状态
+	events []authEvent              // 统计窗口内的认证结果
+}
+
+// NewLoginCollector 创建登录审计采集器
+func NewLoginCollector(spec LoginSpec) *LoginCollector {
+	return &LoginCollector{
+		spec: spec,
+		logs: make(map[string]*authLogState),
+	}
+}
+
+// SetSpec 更新配置，之后从头重新读取认证日志
+func (c *LoginCollector) SetSpec(spec LoginSpec) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.spec = spec
+	c.logs = make(map[string]*authLogState)
+	c.events = nil
+}
+
+// Name 返回采集器名称
+func (c *LoginCollector) Name() string {
+	return "logins"
+}
+
+// Collect 实现Collector接口
+func (c *LoginCollector) Collect(ctx context.Context, opts Options, info *proto.SystemInfo) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	cutoff := now.Add(-c.spec.Window)
+	logins := &proto.LoginInfo{WindowSeconds: int64(c.spec.Window / time.Second)}
+
+	utmp, err := readUtmp(utmpPath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	logins.Sessions = currentSessions(utmp)
+
+	wtmp, err := readUtmp(wtmpPath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	logins.History = loginHistory(wtmp, c.spec.History)
+
+	authAvailable := c.readAuthLogs(ctx, now)
+	events := c.events[:0]
+	for _, event := range c.events {
+		if !event.time.Before(cutoff) {
+			events = append(events, event)
+		}
+	}
+	c.events = events
+
+	if !authAvailable {
+		// 没有认证日志时从btmp统计失败登录；btmp通常只有root可读，读取失败时不上报失败登录
+		btmp, _ := readUtmp(btmpPath)
+		events = nil
+		for _, record := range btmp {
+			if record.Time.Before(cutoff) {
+				continue
+			}
+			events = append(events, authEvent{time: record.Time, user: record.User, source: record.Host, count: 1})
+		}
+	}
+
+	logins.SshLogins, logins.FailedLogins = summarizeAuthEvents(events)
+
+	if len(logins.Sessions) == 0 && len(logins.History) == 0 && !authAvailable && len(logins.FailedLogins) == 0 {
+		// 没有任何登录记录文件（例如非Linux系统或容器内），不上报
+		return nil
+	}
+	info.Logins = logins
+	return nil
+}
+
+// readAuthLogs 读取各认证日志新增的内容，返回是否有可读的认证日志
+func (c *LoginCollector) readAuthLogs(ctx context.Context, now time.Time) bool {
+	available := false
+	for _, path := range c.spec.AuthLogs {
+		if ctx.Err() != nil {
+			break
+		}
+		file, err := os.Open(path)
+		if err != nil {
+			delete(c.logs, path)
+			continue
+		}
+		available = true
+		c.readAuthLog(file, path, now)
+		file.Close()
+	}
+	return available
+}
+
+// readAuthLog 从上次的位置读取一个认证日志中的完整行
+func (c *LoginCollector) readAuthLog(file *os.File, path string, now time.Time) {
+	info, err := file.Stat()
+	if err != nil {
+		return
+	}
+
+	state := c.logs[path]
+	inode := fileInode(info)
+	if state == nil || (inode != 0 && inode != state.inode) || info.Size() < state.offset {
+		// 首次读取或日志已轮转、截断：轮转前的认证结果已在内存中，从新文件开头读取
+		state = &authLogState{inode: inode}
+		c.logs[path] = state
+	}
+	if _, err := file.Seek(state.offset, io.SeekStart); err != nil {
+		return
+	}
+
+	reader := bufio.NewReaderSize(file, 64*1024)
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			// 不完整的行留到下一次读取
+			return
+		}
+		state.offset += int64(len(line))
+		if event, ok := parseAuthLine(strings.TrimRight(line, "\r\n"), now); ok {
+			c.events = append(c.events, event)
+		}
+	}
+}
+
+// parseAuthLine 解析sshd的认证成功和失败日志
+func parseAuthLine(line string, now time.Time) (authEvent, bool) {
+	m := sshdLinePattern.FindStringSubmatch(line)
+	if m == nil {
+		return authEvent{}, false
+	}
+	message := m[1]
+
+	count := 1
+	if r := repeatedPattern.FindStringSubmatch(message); r != nil {
+		count, _ = strconv.Atoi(r[1])
+		message = r[2]
+	}
+
+	event := authEvent{count: count}
+	if r := acceptedPattern.FindStringSubmatch(message); r != nil {
+		event.success = true
+		event.method, event.user, event.source = r[1], r[2], r[3]
+	} else if r := failedPattern.FindStringSubmatch(message); r != nil {
+		event.method, event.user, event.source = r[1], r[2], r[3]
+	} else {
+		return authEvent{}, false
+	}
+
+	t, ok := parseSyslogTime(line, now)
+	if !ok {
+		return authEvent{}, false
+	}
+	event.time = t
+	return event, true
+}
+
+// parseSyslogTime 解析syslog行首的时间，支持RFC3339和传统的 "Jan  2 15:04:05" 格式
+// 传统格式没有年份，取不晚于当前时间的最近一年
+func parseSyslogTime(line string, now time.Time) (time.Time, bool) {
+	if field, _, ok := strings.Cut(line, " "); ok {
+		if t, err := time.Parse(time.RFC3339Nano, field); err == nil {
+			return t, true
+		}
+	}
+
+	if len(line) < 15 {
+		return time.Time{}, false
+	}
+	t, err := time.ParseInLocation("Jan _2 15:04:05", line[:15], time.Local)
+	if err != nil {
+		return time.Time{}, false
+	}
+	t = t.AddDate(now.Year(), 0, 0)
+	if t.After(now.Add(24 * time.Hour)) {
+		t = t.AddDate(-1, 0, 0)
+	}
+	return t, true
+}
+
+// summarizeAuthEvents 整理成功的SSH登录（最新的在前）和按来源统计的失败登录（次数多的在前）
+func summarizeAuthEvents(events []authEvent) ([]*proto.SSHLogin, []*proto.FailedLoginSource) {
+	var logins []*proto.SSHLogin
+	failures := make(map[string]*proto.FailedLoginSource)
+
+	for _, event := range events {
+		if event.success {
+			logins = append(logins, &proto.SSHLogin{
+				User:      event.user,
+				SourceIp:  event.source,
+				Method:    event.method,
+				Timestamp: event.time.Unix(),
+			})
+			continue
+		}
+
+		source := failures[event.source]
+		if source == nil {
+			source = &proto.FailedLoginSource{
+				Source:       event.source,
+				FirstAttempt: event.time.Unix(),
+			}
+			failures[event.source] = source
+		}
+		source.Count += int32(event.count)
+		source.FirstAttempt = min(source.FirstAttempt, event.time.Unix())
+		source.LastAttempt = max(source.LastAttempt, event.time.Unix())
+		if event.user != "" && len(source.Users) < maxFailedLoginUsers && !containsString(source.Users, event.user) {
+			source.Users = append(source.Users, event.user)
+		}
+	}
+
+	sort.SliceStable(logins, func(i, j int) bool {
+		return logins[i].Timestamp > logins[j].Timestamp
+	})
+	if len(logins) > maxSSHLogins {
+		logins = logins[:maxSSHLogins]
+	}
+
+	sources := make([]*proto.FailedLoginSource, 0, len(failures))
+	for _, source := range failures {
+		sources = append(sources, source)
+	}
+	sort.Slice(sources, func(i, j int) bool {
+		if sources[i].Count != sources[j].Count {
+			return sources[i].Count > sources[j].Count
+		}
+		return sources[i].Source < sources[j].Source
+	})
+	return logins, sources
+}
+
+// currentSessions 返回utmp中的用户会话
+func currentSessions(records []utmpRecord) []*proto.LoginSession {
+	var sessions []*proto.LoginSession
+	for _, record := range records {
+		if record.Type != utmpUserProcess || record.User == "" {
+			continue
+		}
+		sessions = append(sessions, &proto.LoginSession{
+			User:      record.User,
+			Tty:       record.Line,
+			Host:      record.Host,
+			LoginTime: record.Time.Unix(),
+			Pid:       record.PID,
+		})
+	}
+	return sessions
+}
+
+// loginHistory 按wtmp中的记录还原最近limit次登录，最新的在前
+// 登录与之后同一终端的注销记录配对；重启或关机时仍未注销的会话以该时间为注销时间
+func loginHistory(records []utmpRecord, limit int) []*proto.LoginSession {
+	if limit <= 0 {
+		return nil
+	}
+
+	var history []*proto.LoginSession
+	open := make(map[string]*proto.LoginSession)
+	for _, record := range records {
+		switch {
+		case record.Type == utmpUserProcess && record.User != "":
+			session := &proto.LoginSession{
+				User:      record.User,
+				Tty:       record.Line,
+				Host:      record.Host,
+				LoginTime: record.Time.Unix(),
+				Pid:       record.PID,
+			}
+			history = append(history, session)
+			open[record.Line] = session
+		case record.Type == utmpDeadProcess:
+			if session, ok := open[record.Line]; ok {
+				session.LogoutTime = record.Time.Unix()
+				delete(open, record.Line)
+			}
+		case record.Type == utmpBootTime || (record.Type == utmpRunLevel && record.User == "shutdown"):
+			for line, session := range open {
+				session.LogoutTime = record.Time.Unix()
+				delete(open, line)
+			}
+		}
+	}
+
+	if len(history) > limit {
+		history = history[len(history)-limit:]
+	}
+	for i, j := 0, len(history)-1; i < j; i, j = i+1, j-1 {
+		history[i], history[j] = history[j], history[i]
+	}
+	return history
+}
@@ -0,0 +1,41 @@
+package collectors
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"time"
+)
+
+// utmpRecordSize 是glibc中struct utmp的大小，64位系统上为与32位程序兼容同样使用32位的时间字段
+const utmpRecordSize = 384
+
+// readUtmp 读取utmp格式的文件（utmp、wtmp、btmp）
+func readUtmp(path string) ([]utmpRecord, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]utmpRecord, 0, len(data)/utmpRecordSize)
+	for offset := 0; offset+utmpRecordSize <= len(data); offset += utmpRecordSize {
+		b := data[offset : offset+utmpRecordSize]
+		records = append(records, utmpRecord{
+			Type: int16(binary.NativeEndian.Uint16(b[0:2])),
+			PID:  int32(binary.NativeEndian.Uint32(b[4:8])),
+			Line: cString(b[8:40]),
+			User: cString(b[44:76]),
+			Host: cString(b[76:332]),
+			Time: time.Unix(int64(int32(binary.NativeEndian.Uint32(b[340:344]))), int64(int32(binary.NativeEndian.Uint32(b[344:348])))*1000),
+		})
+	}
+	return records, nil
+}
+
+// cString 返回以NUL结尾的定长字段中的字符串
+func cString(b []byte) string {
+	if i := bytes.IndexByte(b, 0); i >= 0 {
+		b = b[:i]
+	}
+	return string(b)
+}
@@ -0,0 +1,55 @@
+package collectors
+
+import (
+	"encoding/binary"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// utmpBytes 按glibc的struct utmp布局编码一条记录
+func utmpBytes(record utmpRecord) []byte {
+	b := make([]byte, utmpRecordSize)
+	binary.NativeEndian.PutUint16(b[0:2], uint16(record.Type))
+	binary.NativeEndian.PutUint32(b[4:8], uint32(record.PID))
+	copy(b[8:40], record.Line)
+	copy(b[44:76], record.User)
+	copy(b[76:332], record.Host)
+	binary.NativeEndian.PutUint32(b[340:344], uint32(record.Time.Unix()))
+	binary.NativeEndian.PutUint32(b[344:348], uint32(record.Time.Nanosecond()/1000))
+	return b
+}
+
+func TestReadUtmp(t *testing.T) {
+	records := []utmpRecord{
+		{Type: 7, PID: 1234, Line: "pts/0", User: "alice", Host: "192.0.2.10", Time: time.Unix(1767225600, 250000000)},
+		{Type: 8, PID: 1234, Line: "pts/0", Time: time.Unix(1767229200, 0)},
+		{Type: 2, Line: "~", User: "reboot", Host: "6.1.0-18-amd64", Time: time.Unix(1767200000, 0)},
+	}
+	var data []byte
+	for _, record := range records {
+		data = append(data, utmpBytes(record)...)
+	}
+	// 末尾不完整的记录被忽略
+	data = append(data, make([]byte, utmpRecordSize/2)...)
+
+	root := t.TempDir()
+	writeFixture(t, root, map[string]string{"wtmp": string(data)})
+	got, err := readUtmp(filepath.Join(root, "wtmp"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(records) {
+		t.Fatalf("得到 %d 条记录，期望 %d 条", len(got), len(records))
+	}
+	for i, want := range records {
+		if got[i].Type != want.Type || got[i].PID != want.PID || got[i].Line != want.Line ||
+			got[i].User != want.User || got[i].Host != want.Host || !got[i].Time.Equal(want.Time) {
+			t.Errorf("第 %d 条 = %+v, 期望 %+v", i, got[i], want)
+		}
+	}
+
+	if _, err := readUtmp(filepath.Join(root, "missing")); err == nil {
+		t.Fatal("文件不存在时未返回错误")
+	}
+}
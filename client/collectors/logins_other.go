@@ -0,0 +1,8 @@
+//go:build !linux
+
+package collectors
+
+// readUtmp 在不支持的系统上不读取登录记录
+func readUtmp(path string) ([]utmpRecord, error) {
+	return nil, nil
+}
@@ -0,0 +1,89 @@
+package collectors
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseSyslogTime(t *testing.T) {
+	now := time.Date(2026, 1, 1, 10, 0, 0, 0, time.Local)
+	tests := []struct {
+		name string
+		line string
+		want time.Time
+		ok   bool
+	}{
+		{"RFC3339", "2025-12-31T23:59:58.123456+00:00 host sshd[1]: x", time.Date(2025, 12, 31, 23, 59, 58, 123456000, time.UTC), true},
+		{"传统格式", "Jan  1 09:30:00 host sshd[1]: x", time.Date(2026, 1, 1, 9, 30, 0, 0, time.Local), true},
+		{"跨年取上一年", "Dec 31 23:00:00 host sshd[1]: x", time.Date(2025, 12, 31, 23, 0, 0, 0, time.Local), true},
+		{"时钟偏差一天内仍为今年", "Jan  1 20:00:00 host sshd[1]: x", time.Date(2026, 1, 1, 20, 0, 0, 0, time.Local), true},
+		{"过短", "Jan  1", time.Time{}, false},
+		{"无法解析", "not a syslog line at all", time.Time{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseSyslogTime(tt.line, now)
+			if ok != tt.ok || !got.Equal(tt.want) {
+				t.Fatalf("parseSyslogTime(%q) = (%v, %v), 期望 (%v, %v)", tt.line, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func TestParseAuthLine(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.Local)
+	at := time.Date(2026, 3, 1, 11, 0, 0, 0, time.Local)
+	tests := []struct {
+		name string
+		line string
+		want authEvent
+		ok   bool
+	}{
+		{
+			"公钥登录成功",
+			"Mar  1 11:00:00 web sshd[1234]: Accepted publickey for deploy from 10.0.0.5 port 51234 ssh2: ED25519 SHA256:abc",
+			authEvent{time: at, success: true, user: "deploy", source: "10.0.0.5", method: "publickey", count: 1},
+			true,
+		},
+		{
+			"密码错误",
+			"Mar  1 11:00:00 web sshd[1234]: Failed password for root from 203.0.113.9 port 4242 ssh2",
+			authEvent{time: at, user: "root", source: "203.0.113.9", method: "password", count: 1},
+			true,
+		},
+		{
+			"不存在的用户",
+			"Mar  1 11:00:00 web sshd[1234]: Failed password for invalid user admin from 2001:db8::1 port 22 ssh2",
+			authEvent{time: at, user: "admin", source: "2001:db8::1", method: "password", count: 1},
+			true,
+		},
+		{
+			"rsyslog合并的重复消息",
+			"Mar  1 11:00:00 web sshd[1234]: message repeated 5 times: [ Failed password for root from 203.0.113.9 port 4242 ssh2]",
+			authEvent{time: at, user: "root", source: "203.0.113.9", method: "password", count: 5},
+			true,
+		},
+		{
+			"sshd-session和RFC3339时间",
+			"2026-03-01T11:00:00+08:00 web sshd-session[99]: Accepted password for alice from 192.0.2.1 port 2222 ssh2",
+			authEvent{time: time.Date(2026, 3, 1, 11, 0, 0, 0, time.FixedZone("", 8*3600)), success: true, user: "alice", source: "192.0.2.1", method: "password", count: 1},
+			true,
+		},
+		{"其他sshd消息", "Mar  1 11:00:00 web sshd[1234]: Connection closed by 10.0.0.5 port 51234", authEvent{}, false},
+		{"其他程序", "Mar  1 11:00:00 web sudo[1]: Accepted password for root from 10.0.0.1 port 1 ssh2", authEvent{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseAuthLine(tt.line, now)
+			if ok != tt.ok {
+				t.Fatalf("ok = %v, 期望 %v", ok, tt.ok)
+			}
+			if !got.time.Equal(tt.want.time) || got.success != tt.want.success || got.user != tt.want.user ||
+				got.source != tt.want.source || got.method != tt.want.method || got.count != tt.want.count {
+				t.Fatalf("parseAuthLine = %+v, 期望 %+v", got, tt.want)
+			}
+		})
+	}
+}
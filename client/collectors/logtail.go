@@ -0,0 +1,525 @@
+package collectors
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	"GoMonitor/proto"
+)
+
+const (
+	// logPollInterval 是检查日志文件新内容、轮转和截断的间隔
+	logPollInterval = time.Second
+	// maxLogLineLength 是单行日志的最大长度，超出部分被丢弃
+	maxLogLineLength = 16 * 1024
+	// journalRestartDelay 是journalctl退出后重新启动前的等待时间
+	journalRestartDelay = 5 * time.Second
+	// JournalSource 是journald在读取位置中的键
+	JournalSource = "journald"
+)
+
+// LogFileSpec 描述一个被跟踪的日志文件
+type LogFileSpec struct {
+	Path    string // 绝对路径，支持通配符
+	Include []string
+	Exclude []string
+}
+
+// JournalSpec 描述journald日志的读取方式
+type JournalSpec struct {
+	Enabled bool
+	Units   []string
+	Include []string
+	Exclude []string
+}
+
+// LogPosition 是日志源的读取位置：文件为inode和偏移，journald为游标
+type LogPosition struct {
+	Inode  uint64 `json:"inode,omitempty"`
+	Offset int64  `json:"offset,omitempty"`
+	Cursor string `json:"cursor,omitempty"`
+}
+
+// LogRecord 是读取到的一行日志，Key和Position表示读完该行后对应日志源的位置
+type LogRecord struct {
+	Line     *proto.LogLine
+	Key      string
+	Position LogPosition
+}
+
+// LogFileKey 返回日志文件在读取位置中的键
+func LogFileKey(path string) string {
+	return "file:" + path
+}
+
+// LogReader 跟踪一组日志文件和journald，将读取到的行写入输出通道
+// 输出通道满时读取暂停，由消费方控制节奏
+type LogReader struct {
+	out    chan<- LogRecord
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewLogReader 创建日志读取器，读取到的行写入out
+func NewLogReader(out chan<- LogRecord) *LogReader {
+	return &LogReader{out: out}
+}
+
+// Start 开始读取，positions为各日志源上次的位置
+// 没有记录位置的已有文件从末尾开始读取，之后新出现的文件从头读取
+// 调用前必须先Stop之前的读取
+func (r *LogReader) Start(files []LogFileSpec, journal JournalSpec, positions map[string]LogPosition) error {
+	type fileSource struct {
+		spec   LogFileSpec
+		filter *lineFilter
+	}
+	sources := make([]fileSource, 0, len(files))
+	for _, spec := range files {
+		filter, err := newLineFilter(spec.Include, spec.Exclude)
+		if err != nil {
+			return fmt.Errorf("%s: %w", spec.Path, err)
+		}
+		sources = append(sources, fileSource{spec: spec, filter: filter})
+	}
+	journalFilter, err := newLineFilter(journal.Include, journal.Exclude)
+	if err != nil {
+		return fmt.Errorf("journald: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+
+	for _, source := range sources {
+		f := &fileFollower{
+			pattern:   source.spec.Path,
+			filter:    source.filter,
+			out:       r.out,
+			positions: positions,
+			files:     make(map[string]*followedFile),
+		}
+		r.wg.Add(1)
+		go func() {
+			defer r.wg.Done()
+			f.run(ctx)
+		}()
+	}
+
+	if journal.Enabled {
+		j := &journalFollower{
+			units:  journal.Units,
+			filter: journalFilter,
+			out:    r.out,
+			cursor: positions[JournalSource].Cursor,
+		}
+		r.wg.Add(1)
+		go func() {
+			defer r.wg.Done()
+			j.run(ctx)
+		}()
+	}
+	return nil
+}
+
+// Stop 停止读取并等待所有读取goroutine退出
+func (r *LogReader) Stop() {
+	if r.cancel != nil {
+		r.cancel()
+		r.cancel = nil
+	}
+	r.wg.Wait()
+}
+
+// lineFilter 按包含和排除正则表达式过滤日志行
+type lineFilter struct {
+	include []*regexp.Regexp
+	exclude []*regexp.Regexp
+}
+
+func newLineFilter(include, exclude []string) (*lineFilter, error) {
+	f := &lineFilter{}
+	for _, expr := range include {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, err
+		}
+		f.include = append(f.include, re)
+	}
+	for _, expr := range exclude {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, err
+		}
+		f.exclude = append(f.exclude, re)
+	}
+	return f, nil
+}
+
+// match 判断一行是否需要转发
+func (f *lineFilter) match(line string) bool {
+	for _, re := range f.exclude {
+		if re.MatchString(line) {
+			return false
+		}
+	}
+	if len(f.include) == 0 {
+		return true
+	}
+	for _, re := range f.include {
+		if re.MatchString(line) {
+			return true
+		}
+	}
+	return false
+}
+
+// followedFile 是一个正在跟踪的文件
+type followedFile struct {
+	file       *os.File
+	inode      uint64
+	offset     int64  // 已处理完整行之后的位置
+	partial    []byte // 尚未遇到换行符的内容
+	discarding bool   // 超长的行已截断转发，正在跳过其剩余部分直到换行符
+}
+
+// fileFollower 跟踪一个路径模式匹配的所有文件
+type fileFollower struct {
+	pattern   string
+	filter    *lineFilter
+	out       chan<- LogRecord
+	positions map[string]LogPosition
+	files     map[string]*followedFile
+	started   bool // 首次扫描之后新出现的文件从头读取
+}
+
+func (f *fileFollower) run(ctx context.Context) {
+	defer func() {
+		for _, file := range f.files {
+			file.file.Close()
+		}
+	}()
+
+	ticker := time.NewTicker(logPollInterval)
+	defer ticker.Stop()
+
+	for {
+		f.poll(ctx)
+		f.started = true
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll 检查所有匹配的文件，读取新增内容
+func (f *fileFollower) poll(ctx context.Context) {
+	matches, _ := filepath.Glob(f.pattern)
+	seen := make(map[string]bool, len(matches))
+	for _, path := range matches {
+		seen[path] = true
+		if err := f.pollFile(ctx, path); err != nil && ctx.Err() == nil {
+			log.Printf("读取日志文件 %s 失败: %v", path, err)
+		}
+	}
+
+	// 文件被删除或移走：读完剩余内容后关闭，轮转后的新文件会在之后的扫描中出现
+	for path, file := range f.files {
+		if seen[path] {
+			continue
+		}
+		f.drain(ctx, path, file, true)
+		file.file.Close()
+		delete(f.files, path)
+	}
+}
+
+// pollFile 读取单个文件的新增内容，处理轮转和截断
+func (f *fileFollower) pollFile(ctx context.Context, path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if !info.Mode().IsRegular() {
+		return nil
+	}
+	inode := fileInode(info)
+
+	file := f.files[path]
+	if file != nil && inode != 0 && inode != file.inode {
+		log.Printf("日志文件 %s 已轮转", path)
+		f.drain(ctx, path, file, true)
+		file.file.Close()
+		delete(f.files, path)
+		file = nil
+	}
+
+	if file == nil {
+		file, err = f.open(path, info)
+		if err != nil {
+			return err
+		}
+		f.files[path] = file
+	}
+
+	if info.Size() < file.offset {
+		log.Printf("日志文件 %s 已被截断，从头读取", path)
+		file.offset = 0
+		file.partial = nil
+		file.discarding = false
+		if _, err := file.file.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+	}
+
+	f.drain(ctx, path, file, false)
+	return nil
+}
+
+// open 打开文件并定位到应开始读取的位置
+func (f *fileFollower) open(path string, info os.FileInfo) (*followedFile, error) {
+	fd, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	file := &followedFile{file: fd, inode: fileInode(info)}
+
+	pos, known := f.positions[LogFileKey(path)]
+	switch {
+	case known && pos.Inode == file.inode && pos.Offset <= info.Size():
+		// 从上次转发到的位置继续
+		file.offset = pos.Offset
+	case known || f.started:
+		// 文件在客户端停止期间轮转，或是运行期间新出现的文件
+		file.offset = 0
+	default:
+		// 首次跟踪已有的文件，不转发历史内容
+		file.offset = info.Size()
+	}
+
+	if _, err := fd.Seek(file.offset, io.SeekStart); err != nil {
+		fd.Close()
+		return nil, err
+	}
+	return file, nil
+}
+
+// drain 读取文件当前末尾之前的所有完整行，final为true时文件即将关闭，末尾不完整的行也一并转发
+// 超过maxLogLineLength的行只转发前maxLogLineLength字节，其余部分直到换行符都被跳过
+func (f *fileFollower) drain(ctx context.Context, path string, file *followedFile, final bool) {
+	reader := bufio.NewReaderSize(file.file, maxLogLineLength)
+	for {
+		chunk, err := reader.ReadSlice('\n')
+		if file.discarding {
+			file.offset += int64(len(chunk))
+			if bytes.HasSuffix(chunk, []byte("\n")) {
+				file.discarding = false
+				// 转发不含日志行的记录以推进读取位置，重启后不会把剩余部分当作新的一行
+				if !f.emit(ctx, path, file, "") {
+					return
+				}
+			}
+			if err != nil && !errors.Is(err, bufio.ErrBufferFull) {
+				return
+			}
+			continue
+		}
+
+		if len(chunk) > 0 {
+			file.partial = append(file.partial, chunk...)
+		}
+
+		if err != nil && len(file.partial) < maxLogLineLength && !(final && len(file.partial) > 0) {
+			// 还没有读到换行符，留到下一次
+			return
+		}
+
+		line := file.partial
+		file.offset += int64(len(line))
+		file.partial = nil
+		// 截断时行尚未结束，跳过剩余部分；文件即将关闭时无需跳过
+		file.discarding = !final && !bytes.HasSuffix(line, []byte("\n"))
+		if len(line) > maxLogLineLength {
+			line = line[:maxLogLineLength]
+		}
+		if !f.emit(ctx, path, file, string(bytes.TrimRight(line, "\r\n"))) {
+			return
+		}
+
+		if err != nil && !errors.Is(err, bufio.ErrBufferFull) {
+			return
+		}
+	}
+}
+
+// emit 将一行写入输出通道，ctx取消时返回false
+func (f *fileFollower) emit(ctx context.Context, path string, file *followedFile, line string) bool {
+	if !f.filter.match(line) {
+		// 被过滤的行也要推进位置，否则重启后会重新读取
+		line = ""
+	}
+
+	record := LogRecord{
+		Key:      LogFileKey(path),
+		Position: LogPosition{Inode: file.inode, Offset: file.offset},
+	}
+	if line != "" {
+		record.Line = &proto.LogLine{
+			Source:    path,
+			Timestamp: time.Now().UnixMilli(),
+			Line:      line,
+		}
+	}
+
+	select {
+	case f.out <- record:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// journalFollower 通过 journalctl -f 读取journald日志
+type journalFollower struct {
+	units  []string
+	filter *lineFilter
+	out    chan<- LogRecord
+	cursor string // 最后读取的条目的游标
+}
+
+// journalEntry 是 journalctl -o json 输出的一条记录中用到的字段
+type journalEntry struct {
+	Cursor     string          `json:"__CURSOR"`
+	Realtime   string          `json:"__REALTIME_TIMESTAMP"` // 微秒
+	Unit       string          `json:"_SYSTEMD_UNIT"`
+	Identifier string          `json:"SYSLOG_IDENTIFIER"`
+	Message    json.RawMessage `json:"MESSAGE"`
+}
+
+func (j *journalFollower) run(ctx context.Context) {
+	for {
+		err := j.follow(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if errors.Is(err, exec.ErrNotFound) {
+			log.Printf("未找到journalctl，停止读取journald日志")
+			return
+		}
+		log.Printf("journalctl 退出: %v，%v后重启", err, journalRestartDelay)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(journalRestartDelay):
+		}
+	}
+}
+
+// follow 启动一次journalctl并读取其输出，直到进程退出或ctx取消
+func (j *journalFollower) follow(ctx context.Context) error {
+	args := []string{"-f", "-o", "json", "--no-pager"}
+	if j.cursor != "" {
+		args = append(args, "--after-cursor", j.cursor)
+	} else {
+		// 首次读取不转发历史日志
+		args = append(args, "-n", "0")
+	}
+	for _, unit := range j.units {
+		args = append(args, "-u", unit)
+	}
+
+	cmd := exec.CommandContext(ctx, "journalctl", args...)
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	scanner := bufio.NewScanner(stdout)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var entry journalEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil || entry.Cursor == "" {
+			continue
+		}
+		if !j.emit(ctx, &entry) {
+			break
+		}
+	}
+
+	// 读取提前结束时结束进程，避免Wait阻塞
+	if cmd.Process != nil {
+		cmd.Process.Kill()
+	}
+	return cmd.Wait()
+}
+
+// emit 将一条journald记录写入输出通道，ctx取消时返回false
+func (j *journalFollower) emit(ctx context.Context, entry *journalEntry) bool {
+	j.cursor = entry.Cursor
+	record := LogRecord{
+		Key:      JournalSource,
+		Position: LogPosition{Cursor: entry.Cursor},
+	}
+
+	message := journalMessage(entry.Message)
+	if len(message) > maxLogLineLength {
+		message = message[:maxLogLineLength]
+	}
+	if message != "" && j.filter.match(message) {
+		name := entry.Unit
+		if name == "" {
+			name = entry.Identifier
+		}
+		timestamp := time.Now().UnixMilli()
+		if usec, err := strconv.ParseInt(entry.Realtime, 10, 64); err == nil {
+			timestamp = usec / 1000
+		}
+		record.Line = &proto.LogLine{
+			Source:    JournalSource + ":" + name,
+			Timestamp: timestamp,
+			Line:      message,
+		}
+	}
+
+	select {
+	case j.out <- record:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// journalMessage 解析MESSAGE字段，包含非UTF-8内容时journalctl将其输出为字节数组
+func journalMessage(raw json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+	var b []byte
+	var ints []int
+	if err := json.Unmarshal(raw, &ints); err == nil {
+		b = make([]byte, len(ints))
+		for i, v := range ints {
+			b[i] = byte(v)
+		}
+	}
+	return string(bytes.TrimRight(b, "\n"))
+}
@@ -0,0 +1,90 @@
+package collectors
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// pollLines 读取一次文件的新增内容，返回转发的日志行和最后的读取位置
+func pollLines(t *testing.T, f *fileFollower, path string, out chan LogRecord) ([]string, int64) {
+	t.Helper()
+	if err := f.pollFile(context.Background(), path); err != nil {
+		t.Fatal(err)
+	}
+
+	var lines []string
+	var offset int64
+	for {
+		select {
+		case record := <-out:
+			if record.Line != nil {
+				lines = append(lines, record.Line.Line)
+			}
+			offset = record.Position.Offset
+		default:
+			return lines, offset
+		}
+	}
+}
+
+func TestFileFollowerTruncatesLongLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	long := strings.Repeat("a", maxLogLineLength+5000)
+	content := "short\n" + long + "\nnext\n"
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	out := make(chan LogRecord, 100)
+	filter, _ := newLineFilter(nil, nil)
+	f := &fileFollower{pattern: path, filter: filter, out: out, files: make(map[string]*followedFile), started: true}
+
+	lines, offset := pollLines(t, f, path, out)
+	want := []string{"short", long[:maxLogLineLength], "next"}
+	if len(lines) != len(want) {
+		t.Fatalf("转发了 %d 行，期望 %d 行", len(lines), len(want))
+	}
+	for i := range want {
+		if lines[i] != want[i] {
+			t.Fatalf("第 %d 行长度 %d，期望 %d", i, len(lines[i]), len(want[i]))
+		}
+	}
+	if offset != int64(len(content)) {
+		t.Fatalf("读取位置 %d，期望 %d", offset, len(content))
+	}
+}
+
+func TestFileFollowerDiscardsAcrossPolls(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	long := strings.Repeat("b", maxLogLineLength+100)
+	if err := os.WriteFile(path, []byte(long), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	out := make(chan LogRecord, 100)
+	filter, _ := newLineFilter(nil, nil)
+	f := &fileFollower{pattern: path, filter: filter, out: out, files: make(map[string]*followedFile), started: true}
+
+	lines, _ := pollLines(t, f, path, out)
+	if len(lines) != 1 || lines[0] != long[:maxLogLineLength] {
+		t.Fatalf("超长行应截断后转发一次，实际转发 %d 行", len(lines))
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file.WriteString("tail of the long line\nafter\n")
+	file.Close()
+
+	lines, offset := pollLines(t, f, path, out)
+	if len(lines) != 1 || lines[0] != "after" {
+		t.Fatalf("转发了 %q，期望只有 \"after\"", lines)
+	}
+	if want := int64(len(long) + len("tail of the long line\nafter\n")); offset != want {
+		t.Fatalf("读取位置 %d，期望 %d", offset, want)
+	}
+}
@@ -1,18 +1,38 @@
 package collectors
 
 import (
+	"context"
+
 	"GoMonitor/proto"
 	"github.com/shirou/gopsutil/mem"
 )
 
+// MemoryCollector 采集内存和交换分区使用情况，包括可用内存、缓存、脏页、slab和大页等明细
+type MemoryCollector struct{}
+
+// Name 返回采集器名称
+func (c *MemoryCollector) Name() string {
+	return "memory"
+}
+
+// Collect 实现Collector接口
+func (c *MemoryCollector) Collect(ctx context.Context, opts Options, info *proto.SystemInfo) error {
+	memInfo, err := CollectMemoryInfo(ctx)
+	if err != nil {
+		return err
+	}
+	info.MemoryInfo = memInfo
+	return nil
+}
+
 // CollectMemoryInfo 收集内存信息
-func CollectMemoryInfo() (*proto.MemoryInfo, error) {
-	memStat, err := mem.VirtualMemory()
+func CollectMemoryInfo(ctx context.Context) (*proto.MemoryInfo, error) {
+	memStat, err := mem.VirtualMemoryWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	swapStat, err := mem.SwapMemory()
+	swapStat, err := mem.SwapMemoryWithContext(ctx)
 	if err != nil {
 		swapStat = &mem.SwapMemoryStat{
 			Total: 0,
@@ -29,5 +49,16 @@ func CollectMemoryInfo() (*proto.MemoryInfo, error) {
 		SwapTotal:          int64(swapStat.Total),
 		SwapUsed:           int64(swapStat.Used),
 		SwapFree:           int64(swapStat.Free),
+		AvailableMemory:    int64(memStat.Available),
+		Buffers:            int64(memStat.Buffers),
+		Cached:             int64(memStat.Cached),
+		Shared:             int64(memStat.Shared),
+		Dirty:              int64(memStat.Dirty),
+		Writeback:          int64(memStat.Writeback),
+		Slab:               int64(memStat.Slab),
+		SlabReclaimable:    int64(memStat.SReclaimable),
+		HugepagesTotal:     int64(memStat.HugePagesTotal),
+		HugepagesFree:      int64(memStat.HugePagesFree),
+		HugepageSize:       int64(memStat.HugePageSize),
 	}, nil
 }
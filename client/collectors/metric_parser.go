@@ -0,0 +1,209 @@
+package collectors
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// 插件输出格式
+const (
+	FormatKeyValue   = "keyvalue"
+	FormatJSON       = "json"
+	FormatPrometheus = "prometheus"
+)
+
+// ParseMetrics 按指定格式解析插件输出，返回指标名到值的映射
+func ParseMetrics(format string, output []byte) (map[string]string, error) {
+	switch format {
+	case FormatKeyValue, "":
+		return parseKeyValue(output)
+	case FormatJSON:
+		return parseJSON(output)
+	case FormatPrometheus:
+		return parsePrometheus(output)
+	default:
+		return nil, fmt.Errorf("未知的输出格式: %s", format)
+	}
+}
+
+// parseKeyValue 解析每行一个 key=value 的输出，忽略空行和以#开头的注释
+func parseKeyValue(output []byte) (map[string]string, error) {
+	metrics := make(map[string]string)
+
+	scanner := bufio.NewScanner(strings.NewReader(string(output)))
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, value, found := strings.Cut(line, "=")
+		key = strings.TrimSpace(key)
+		if !found || key == "" {
+			return nil, fmt.Errorf("第 %d 行不是 key=value 格式: %q", lineNo, line)
+		}
+		metrics[key] = strings.TrimSpace(value)
+	}
+
+	return metrics, scanner.Err()
+}
+
+// parseJSON 解析JSON对象，嵌套对象的键用"."连接展开
+func parseJSON(output []byte) (map[string]string, error) {
+	var root map[string]interface{}
+	if err := json.Unmarshal(output, &root); err != nil {
+		return nil, fmt.Errorf("解析JSON失败: %w", err)
+	}
+
+	metrics := make(map[string]string)
+	flattenJSON("", root, metrics)
+	return metrics, nil
+}
+
+func flattenJSON(prefix string, value interface{}, metrics map[string]string) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			name := key
+			if prefix != "" {
+				name = prefix + "." + key
+			}
+			flattenJSON(name, child, metrics)
+		}
+	case []interface{}:
+		for i, child := range v {
+			flattenJSON(fmt.Sprintf("%s.%d", prefix, i), child, metrics)
+		}
+	case float64:
+		metrics[prefix] = strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		metrics[prefix] = strconv.FormatBool(v)
+	case string:
+		metrics[prefix] = v
+	case nil:
+		// 忽略null值
+	}
+}
+
+// parsePrometheus 解析Prometheus文本格式
+// 指标名为 name{label="value",...}，标签按名称排序；忽略注释和时间戳
+func parsePrometheus(output []byte) (map[string]string, error) {
+	metrics := make(map[string]string)
+
+	scanner := bufio.NewScanner(strings.NewReader(string(output)))
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		name, rest, err := splitPrometheusSeries(line)
+		if err != nil {
+			return nil, fmt.Errorf("第 %d 行格式错误: %w", lineNo, err)
+		}
+
+		fields := strings.Fields(rest)
+		if len(fields) == 0 {
+			return nil, fmt.Errorf("第 %d 行缺少指标值: %q", lineNo, line)
+		}
+		if _, err := strconv.ParseFloat(fields[0], 64); err != nil {
+			return nil, fmt.Errorf("第 %d 行的指标值无效: %q", lineNo, fields[0])
+		}
+
+		metrics[name] = fields[0]
+	}
+
+	return metrics, scanner.Err()
+}
+
+// splitPrometheusSeries 拆分出规范化的序列名（含排序后的标签）和剩余部分
+func splitPrometheusSeries(line string) (string, string, error) {
+	brace := strings.IndexByte(line, '{')
+	space := strings.IndexAny(line, " \t")
+	if brace < 0 || (space >= 0 && space < brace) {
+		if space < 0 {
+			return "", "", fmt.Errorf("缺少指标值: %q", line)
+		}
+		return line[:space], line[space:], nil
+	}
+
+	end := closingBrace(line, brace+1)
+	if end < 0 {
+		return "", "", fmt.Errorf("标签缺少右括号: %q", line)
+	}
+
+	labels, err := parsePrometheusLabels(line[brace+1 : end])
+	if err != nil {
+		return "", "", err
+	}
+
+	name := line[:brace]
+	if len(labels) > 0 {
+		name += "{" + strings.Join(labels, ",") + "}"
+	}
+	return name, line[end+1:], nil
+}
+
+// closingBrace 从start开始查找标签列表的右括号，跳过引号内的内容（标签值可以包含 "}"）和转义字符，找不到时返回-1
+func closingBrace(line string, start int) int {
+	quoted := false
+	for i := start; i < len(line); i++ {
+		switch {
+		case quoted && line[i] == '\\':
+			i++
+		case line[i] == '"':
+			quoted = !quoted
+		case !quoted && line[i] == '}':
+			return i
+		}
+	}
+	return -1
+}
+
+// parsePrometheusLabels 解析 a="x",b="y" 形式的标签列表并按名称排序
+func parsePrometheusLabels(raw string) ([]string, error) {
+	var labels []string
+
+	for raw = strings.TrimSpace(raw); raw != ""; raw = strings.TrimSpace(raw) {
+		eq := strings.IndexByte(raw, '=')
+		if eq < 0 {
+			return nil, fmt.Errorf("标签格式错误: %q", raw)
+		}
+		key := strings.TrimSpace(raw[:eq])
+		raw = strings.TrimSpace(raw[eq+1:])
+
+		if !strings.HasPrefix(raw, `"`) {
+			return nil, fmt.Errorf("标签 %s 的值缺少引号", key)
+		}
+
+		// 查找未被转义的结束引号
+		end := -1
+		for i := 1; i < len(raw); i++ {
+			if raw[i] == '\\' {
+				i++
+				continue
+			}
+			if raw[i] == '"' {
+				end = i
+				break
+			}
+		}
+		if end < 0 {
+			return nil, fmt.Errorf("标签 %s 的值缺少结束引号", key)
+		}
+
+		labels = append(labels, key+"="+raw[:end+1])
+		raw = strings.TrimPrefix(strings.TrimSpace(raw[end+1:]), ",")
+	}
+
+	sort.Strings(labels)
+	return labels, nil
+}
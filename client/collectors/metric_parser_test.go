@@ -0,0 +1,100 @@
+package collectors
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseMetrics(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		output  string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name:   "keyvalue",
+			format: FormatKeyValue,
+			output: "# 注释\nqueue.depth = 12\n\nworkers=4\n",
+			want:   map[string]string{"queue.depth": "12", "workers": "4"},
+		},
+		{
+			name:    "keyvalue缺少等号",
+			format:  FormatKeyValue,
+			output:  "queue.depth 12\n",
+			wantErr: true,
+		},
+		{
+			name:   "json嵌套对象和数组",
+			format: FormatJSON,
+			output: `{"db": {"connections": 10, "ok": true}, "shards": [1.5, 2], "note": null}`,
+			want:   map[string]string{"db.connections": "10", "db.ok": "true", "shards.0": "1.5", "shards.1": "2"},
+		},
+		{
+			name:   "prometheus标签排序并忽略时间戳",
+			format: FormatPrometheus,
+			output: "# HELP http_requests_total 请求数\n" +
+				"# TYPE http_requests_total counter\n" +
+				"http_requests_total{method=\"post\",code=\"200\"} 1027 1395066363000\n" +
+				"up 1\n",
+			want: map[string]string{
+				`http_requests_total{code="200",method="post"}`: "1027",
+				"up": "1",
+			},
+		},
+		{
+			name:   "prometheus标签值包含右括号和转义引号",
+			format: FormatPrometheus,
+			output: `fs_free{path="/a}b",label="say \"}\""} 42` + "\n",
+			want:   map[string]string{`fs_free{label="say \"}\"",path="/a}b"}`: "42"},
+		},
+		{
+			name:   "prometheus空标签列表",
+			format: FormatPrometheus,
+			output: "up{} 1\n",
+			want:   map[string]string{"up": "1"},
+		},
+		{
+			name:   "prometheus特殊值",
+			format: FormatPrometheus,
+			output: "a NaN\nb +Inf\n",
+			want:   map[string]string{"a": "NaN", "b": "+Inf"},
+		},
+		{
+			name:    "prometheus缺少右括号",
+			format:  FormatPrometheus,
+			output:  `up{job="x" 1` + "\n",
+			wantErr: true,
+		},
+		{
+			name:    "prometheus指标值无效",
+			format:  FormatPrometheus,
+			output:  "up one\n",
+			wantErr: true,
+		},
+		{
+			name:    "未知格式",
+			format:  "xml",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseMetrics(tt.format, []byte(tt.output))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("期望错误，得到 %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("得到 %v，期望 %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,21 +1,66 @@
 package collectors
 
 import (
+	"context"
 	"strings"
 
+	"GoMonitor/pkg/models"
 	"GoMonitor/pkg/utils"
 	"GoMonitor/proto"
 	psnet "github.com/shirou/gopsutil/net"
 )
 
+// 网络接口计数器在rateTracker中的下标
+const (
+	netRxBytes = iota
+	netTxBytes
+	netRxPackets
+	netTxPackets
+	netRxErrors
+	netTxErrors
+	netRxDrops
+	netTxDrops
+)
+
+// NetworkCollector 采集网络接口信息、流量计数和各接口的速率
+type NetworkCollector struct {
+	rates *rateTracker
+}
+
+// NewNetworkCollector 创建网络采集器，并以当前计数器作为第一个统计窗口的起点
+func NewNetworkCollector() *NetworkCollector {
+	c := &NetworkCollector{
+		rates: newRateTracker(),
+	}
+	if ioStats, err := psnet.IOCountersWithContext(context.Background(), true); err == nil {
+		c.rates.Update(context.Background(), networkCounters(ioStats), true)
+	}
+	return c
+}
+
+// Name 返回采集器名称
+func (c *NetworkCollector) Name() string {
+	return "network"
+}
+
+// Collect 实现Collector接口
+func (c *NetworkCollector) Collect(ctx context.Context, opts Options, info *proto.SystemInfo) error {
+	netInfo, err := c.CollectNetworkInfo(ctx, opts)
+	if err != nil {
+		return err
+	}
+	info.NetworkInfo = netInfo
+	return nil
+}
+
 // CollectNetworkInfo 收集网络信息
-func CollectNetworkInfo() (*proto.NetworkInfo, error) {
-	interfaces, err := psnet.Interfaces()
+func (c *NetworkCollector) CollectNetworkInfo(ctx context.Context, opts Options) (*proto.NetworkInfo, error) {
+	interfaces, err := psnet.InterfacesWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	ioStats, err := psnet.IOCounters(true)
+	ioStats, err := psnet.IOCountersWithContext(ctx, true)
 	if err != nil {
 		ioStats = []psnet.IOCountersStat{}
 	}
@@ -35,8 +80,10 @@ func CollectNetworkInfo() (*proto.NetworkInfo, error) {
 		netInfo.PacketsReceived += int64(io.PacketsRecv)
 	}
 
+	rates, elapsed := c.rates.Update(ctx, networkCounters(ioStats), !opts.Peek)
+
 	for _, iface := range interfaces {
-		if !utils.ShouldIncludeInterface(iface.Name) {
+		if !opts.includeInterface(iface.Name) {
 			continue
 		}
 
@@ -48,9 +95,16 @@ func CollectNetworkInfo() (*proto.NetworkInfo, error) {
 			}
 		}
 
+		addrs := make([]string, len(iface.Addrs))
+		for i, addr := range iface.Addrs {
+			addrs[i] = addr.Addr
+		}
+		ipNets := utils.ParseInterfaceAddresses(addrs)
+
 		netIface := &proto.NetworkInterface{
 			Name:          iface.Name,
-			IpAddress:     getInterfaceIPAddress(iface),
+			IpAddress:     utils.PrimaryIPAddress(ipNets),
+			IpAddresses:   models.IPAddressesFromNets(ipNets),
 			MacAddress:    iface.HardwareAddr,
 			BytesSent:     0,
 			BytesReceived: 0,
@@ -65,20 +119,38 @@ func CollectNetworkInfo() (*proto.NetworkInfo, error) {
 			}
 		}
 
+		if r, ok := rates[iface.Name]; ok {
+			netIface.RxBytesPerSec = r.perSecond(netRxBytes)
+			netIface.TxBytesPerSec = r.perSecond(netTxBytes)
+			netIface.RxPacketsPerSec = r.perSecond(netRxPackets)
+			netIface.TxPacketsPerSec = r.perSecond(netTxPackets)
+			netIface.RxErrorsPerSec = r.perSecond(netRxErrors)
+			netIface.TxErrorsPerSec = r.perSecond(netTxErrors)
+			netIface.RxDropsPerSec = r.perSecond(netRxDrops)
+			netIface.TxDropsPerSec = r.perSecond(netTxDrops)
+			netIface.SampleSeconds = elapsed.Seconds()
+		}
+
 		netInfo.Interfaces[iface.Name] = netIface
 	}
 
 	return netInfo, nil
 }
 
-func getInterfaceIPAddress(iface psnet.InterfaceStat) string {
-	for _, addr := range iface.Addrs {
-		// 从CIDR格式中提取IP地址
-		ip := strings.Split(addr.Addr, "/")[0]
-		// 简单检查是否为IPv4地址
-		if strings.Count(ip, ".") == 3 {
-			return ip
+// networkCounters 提取需要计算速率的接口计数器
+func networkCounters(ioStats []psnet.IOCountersStat) map[string][]uint64 {
+	counters := make(map[string][]uint64, len(ioStats))
+	for _, io := range ioStats {
+		counters[io.Name] = []uint64{
+			netRxBytes:   io.BytesRecv,
+			netTxBytes:   io.BytesSent,
+			netRxPackets: io.PacketsRecv,
+			netTxPackets: io.PacketsSent,
+			netRxErrors:  io.Errin,
+			netTxErrors:  io.Errout,
+			netRxDrops:   io.Dropin,
+			netTxDrops:   io.Dropout,
 		}
 	}
-	return ""
+	return counters
 }
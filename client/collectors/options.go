@@ -0,0 +1,53 @@
+package collectors
+
+import (
+	"strings"
+
+	"GoMonitor/pkg/utils"
+)
+
+// Options 控制采集器的过滤行为
+type Options struct {
+	InterfaceExclude    []string // 服务端下发的排除接口名前缀，设置后取代本地的接口过滤规则
+	InterfaceExcludeSet bool     // 服务端是否设置了InterfaceExclude，设置为空列表表示上报所有接口
+	PartitionExclude    []string // 服务端下发的排除挂载点前缀，在本地分区过滤规则之外额外生效
+	Filters             Filters  // 本地配置的过滤规则
+
+	// Peek 为true表示按需查询（例如collect_info命令）
+	// 基于差值计算的采集器不应推进基准，注册表也不会缓存结果，以免影响定期上报
+	Peek bool
+}
+
+// Filters 是本地配置的网络接口和磁盘分区过滤规则，nil表示不过滤
+type Filters struct {
+	Interfaces  *utils.Matcher // 按接口名
+	MountPoints *utils.Matcher // 按挂载点
+	Fstypes     *utils.Matcher // 按文件系统类型
+	Devices     *utils.Matcher // 按设备路径
+}
+
+// includeInterface 判断网络接口是否应被上报
+func (o Options) includeInterface(name string) bool {
+	if o.InterfaceExcludeSet || len(o.InterfaceExclude) > 0 {
+		return !hasAnyPrefix(name, o.InterfaceExclude)
+	}
+	return o.Filters.Interfaces.Match(name)
+}
+
+// includePartition 判断磁盘分区是否应被上报
+func (o Options) includePartition(mountPoint, fstype, device string) bool {
+	if hasAnyPrefix(mountPoint, o.PartitionExclude) {
+		return false
+	}
+	return o.Filters.MountPoints.Match(mountPoint) && o.Filters.Fstypes.Match(fstype) && o.Filters.Devices.Match(device)
+}
+
+// hasAnyPrefix 判断s是否以任一前缀开头
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,34 @@
+package collectors
+
+import (
+	"testing"
+
+	"GoMonitor/pkg/utils"
+)
+
+func TestIncludeInterface(t *testing.T) {
+	local, err := utils.NewMatcher(nil, []string{"docker*"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		opts Options
+		want map[string]bool
+	}{
+		{"本地过滤规则", Options{Filters: Filters{Interfaces: local}}, map[string]bool{"eth0": true, "docker0": false}},
+		{"服务端排除前缀取代本地规则", Options{InterfaceExclude: []string{"eth"}, InterfaceExcludeSet: true, Filters: Filters{Interfaces: local}},
+			map[string]bool{"eth0": false, "docker0": true}},
+		{"服务端设置为空列表", Options{InterfaceExcludeSet: true, Filters: Filters{Interfaces: local}}, map[string]bool{"eth0": true, "docker0": true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for name, want := range tt.want {
+				if got := tt.opts.includeInterface(name); got != want {
+					t.Errorf("includeInterface(%s) = %v, 期望 %v", name, got, want)
+				}
+			}
+		})
+	}
+}
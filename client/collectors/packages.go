@@ -0,0 +1,352 @@
+package collectors
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"GoMonitor/pkg/models"
+	"GoMonitor/proto"
+)
+
+// packageCommandTimeout 是调用rpm、pip、npm列出软件包的超时
+const packageCommandTimeout = time.Minute
+
+// dpkgStatusFile 是dpkg记录软件包安装状态的数据库
+const dpkgStatusFile = "/var/lib/dpkg/status"
+
+// rpmDatabaseDirs 是rpm数据库的可能位置，较新的发行版使用 /usr/lib/sysimage/rpm
+var rpmDatabaseDirs = []string{"/var/lib/rpm", "/usr/lib/sysimage/rpm"}
+
+// PackageCollector 读取dpkg和rpm数据库（以及可选的pip、npm全局包）生成已安装软件包清单
+// 首次上报完整列表，之后只上报相对于上一次上报的增量；服务端无法应用增量时调用Resync重新上报完整列表
+// 不存在的包管理器会被跳过；存在但读取失败时整个采集失败，以免把读取失败误报为软件包被卸载
+type PackageCollector struct {
+	mu       sync.Mutex
+	pip      bool
+	npm      bool
+	baseline map[string]*proto.Package // 上一次上报的完整列表，nil表示下次需要上报完整列表
+	digest   string
+}
+
+// NewPackageCollector 创建软件包采集器，pip和npm为true时额外列出全局安装的Python和Node.js包
+func NewPackageCollector(pip bool, npm bool) *PackageCollector {
+	return &PackageCollector{
+		pip: pip,
+		npm: npm,
+	}
+}
+
+// Name 返回采集器名称
+func (c *PackageCollector) Name() string {
+	return "packages"
+}
+
+// SetSources 更新是否列出pip和npm包，下一次采集上报完整列表
+func (c *PackageCollector) SetSources(pip bool, npm bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.pip = pip
+	c.npm = npm
+	c.baseline = nil
+}
+
+// Resync 使下一次采集上报完整列表
+func (c *PackageCollector) Resync() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.baseline = nil
+}
+
+// Collect 实现Collector接口，按需查询时总是返回完整列表且不影响增量的基准
+func (c *PackageCollector) Collect(ctx context.Context, opts Options, info *proto.SystemInfo) error {
+	packages, err := c.listPackages(ctx)
+	if err != nil {
+		return err
+	}
+
+	current := make(map[string]*proto.Package, len(packages))
+	for _, pkg := range packages {
+		current[models.PackageKey(pkg)] = pkg
+	}
+	digest := models.PackageDigest(current)
+
+	if opts.Peek {
+		info.Packages = &proto.PackageInventory{Full: true, Digest: digest, Packages: packages}
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.baseline == nil {
+		info.Packages = &proto.PackageInventory{Full: true, Digest: digest, Packages: packages}
+	} else {
+		info.Packages = diffPackages(c.baseline, current)
+		info.Packages.BaseDigest = c.digest
+		info.Packages.Digest = digest
+	}
+
+	c.baseline = current
+	c.digest = digest
+	return nil
+}
+
+// diffPackages 返回从previous到current的增量，结果按包名排序
+func diffPackages(previous, current map[string]*proto.Package) *proto.PackageInventory {
+	inv := &proto.PackageInventory{}
+	for key, pkg := range current {
+		if old, ok := previous[key]; !ok || old.Version != pkg.Version {
+			inv.Packages = append(inv.Packages, pkg)
+		}
+	}
+	for key, pkg := range previous {
+		if _, ok := current[key]; !ok {
+			inv.Removed = append(inv.Removed, pkg)
+		}
+	}
+	sortPackages(inv.Packages)
+	sortPackages(inv.Removed)
+	return inv
+}
+
+// listPackages 列出所有包管理器中已安装的软件包，按包管理器和包名排序
+func (c *PackageCollector) listPackages(ctx context.Context) ([]*proto.Package, error) {
+	c.mu.Lock()
+	pip, npm := c.pip, c.npm
+	c.mu.Unlock()
+
+	var packages []*proto.Package
+
+	if _, err := os.Stat(dpkgStatusFile); err == nil {
+		dpkg, err := readDpkgStatus(dpkgStatusFile)
+		if err != nil {
+			return nil, fmt.Errorf("读取dpkg数据库失败: %w", err)
+		}
+		packages = append(packages, dpkg...)
+	}
+
+	if hasRPMDatabase() {
+		rpm, err := listRPMPackages(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("读取rpm数据库失败: %w", err)
+		}
+		packages = append(packages, rpm...)
+	}
+
+	if pip {
+		pipPackages, err := listPipPackages(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("列出pip包失败: %w", err)
+		}
+		packages = append(packages, pipPackages...)
+	}
+
+	if npm {
+		npmPackages, err := listNpmPackages(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("列出npm包失败: %w", err)
+		}
+		packages = append(packages, npmPackages...)
+	}
+
+	sortPackages(packages)
+	return packages, nil
+}
+
+// sortPackages 按包管理器、包名和架构排序
+func sortPackages(packages []*proto.Package) {
+	sort.Slice(packages, func(i, j int) bool {
+		a, b := packages[i], packages[j]
+		if a.Manager != b.Manager {
+			return a.Manager < b.Manager
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Arch < b.Arch
+	})
+}
+
+// readDpkgStatus 解析dpkg状态数据库，每个软件包一段 "Key: Value"，段之间以空行分隔
+// 只返回Status为 "install ok installed" 的包，已卸载但保留配置文件的包不计入
+func readDpkgStatus(path string) ([]*proto.Package, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var packages []*proto.Package
+	current := &proto.Package{Manager: "dpkg"}
+	installed := false
+
+	flush := func() {
+		if installed && current.Name != "" {
+			packages = append(packages, current)
+		}
+		current = &proto.Package{Manager: "dpkg"}
+		installed = false
+	}
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			flush()
+			continue
+		}
+		// 以空白开头的是上一字段（例如Description）的续行
+		if line[0] == ' ' || line[0] == '\t' {
+			continue
+		}
+
+		key, value, _ := strings.Cut(line, ":")
+		value = strings.TrimSpace(value)
+		switch key {
+		case "Package":
+			current.Name = value
+		case "Version":
+			current.Version = value
+		case "Architecture":
+			current.Arch = value
+		case "Source":
+			// 源码包版本与二进制包不同时写作 "openssl (3.0.11-1)"
+			source, _, _ := strings.Cut(value, " ")
+			if source != current.Name {
+				current.Source = source
+			}
+		case "Status":
+			installed = strings.HasSuffix(value, " installed")
+		}
+	}
+	flush()
+
+	return packages, scanner.Err()
+}
+
+// hasRPMDatabase 判断系统是否使用rpm管理软件包
+// Debian系发行版上也可能安装了rpm命令，但没有rpm数据库
+func hasRPMDatabase() bool {
+	if _, err := exec.LookPath("rpm"); err != nil {
+		return false
+	}
+	for _, dir := range rpmDatabaseDirs {
+		if entries, err := os.ReadDir(dir); err == nil && len(entries) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// listRPMPackages 通过 rpm -qa 列出软件包，版本格式为 [epoch:]version-release
+func listRPMPackages(ctx context.Context) ([]*proto.Package, error) {
+	result, err := runExternal(ctx, packageCommandTimeout, "rpm",
+		[]string{"-qa", "--queryformat", `%{NAME}\t%|EPOCH?{%{EPOCH}:}:{}|%{VERSION}-%{RELEASE}\t%{ARCH}\t%{SOURCERPM}\n`})
+	if err != nil {
+		return nil, err
+	}
+	if result.ExitCode != 0 {
+		return nil, fmt.Errorf("rpm退出码 %d: %s", result.ExitCode, result.Stderr)
+	}
+
+	var packages []*proto.Package
+	scanner := bufio.NewScanner(bytes.NewReader(result.Stdout))
+	for scanner.Scan() {
+		fields := strings.Split(scanner.Text(), "\t")
+		if len(fields) != 4 {
+			continue
+		}
+		// gpg-pubkey是导入的签名公钥，不是软件包
+		if fields[0] == "gpg-pubkey" {
+			continue
+		}
+		packages = append(packages, &proto.Package{
+			Name:    fields[0],
+			Version: fields[1],
+			Arch:    fields[2],
+			Manager: "rpm",
+			Source:  rpmSourceName(fields[3], fields[0]),
+		})
+	}
+	return packages, scanner.Err()
+}
+
+// rpmSourceName 从源码包文件名（例如 openssl-3.0.7-24.el9.src.rpm）中取出源码包名称，与包名相同时返回空字符串
+func rpmSourceName(sourceRPM string, name string) string {
+	base := strings.TrimSuffix(sourceRPM, ".src.rpm")
+	if base == sourceRPM {
+		return ""
+	}
+	// 去掉末尾的 -version-release
+	for i := 0; i < 2; i++ {
+		idx := strings.LastIndex(base, "-")
+		if idx <= 0 {
+			return ""
+		}
+		base = base[:idx]
+	}
+	if base == name {
+		return ""
+	}
+	return base
+}
+
+// listPipPackages 通过 pip list 列出当前python3环境中的软件包
+func listPipPackages(ctx context.Context) ([]*proto.Package, error) {
+	result, err := runExternal(ctx, packageCommandTimeout, "python3",
+		[]string{"-m", "pip", "list", "--format=json", "--disable-pip-version-check"})
+	if err != nil {
+		return nil, err
+	}
+	if result.ExitCode != 0 {
+		return nil, fmt.Errorf("pip退出码 %d: %s", result.ExitCode, result.Stderr)
+	}
+
+	var list []struct {
+		Name    string `json:"name"`
+		Version string `json:"version"`
+	}
+	if err := json.Unmarshal(result.Stdout, &list); err != nil {
+		return nil, fmt.Errorf("解析pip输出失败: %w", err)
+	}
+
+	packages := make([]*proto.Package, 0, len(list))
+	for _, item := range list {
+		packages = append(packages, &proto.Package{Name: item.Name, Version: item.Version, Manager: "pip"})
+	}
+	return packages, nil
+}
+
+// listNpmPackages 通过 npm ls -g 列出全局安装的Node.js包
+// 依赖有问题时npm以非零退出码结束但仍输出完整的JSON，因此以能否解析输出为准
+func listNpmPackages(ctx context.Context) ([]*proto.Package, error) {
+	result, err := runExternal(ctx, packageCommandTimeout, "npm", []string{"ls", "--global", "--json", "--depth=0"})
+	if err != nil {
+		return nil, err
+	}
+
+	var tree struct {
+		Dependencies map[string]struct {
+			Version string `json:"version"`
+		} `json:"dependencies"`
+	}
+	if err := json.Unmarshal(result.Stdout, &tree); err != nil {
+		return nil, fmt.Errorf("解析npm输出失败（退出码 %d）: %w", result.ExitCode, err)
+	}
+
+	packages := make([]*proto.Package, 0, len(tree.Dependencies))
+	for name, dep := range tree.Dependencies {
+		packages = append(packages, &proto.Package{Name: name, Version: dep.Version, Manager: "npm"})
+	}
+	return packages, nil
+}
@@ -0,0 +1,86 @@
+package collectors
+
+import (
+	"path/filepath"
+	"testing"
+
+	"GoMonitor/proto"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+func TestReadDpkgStatus(t *testing.T) {
+	status := `Package: libssl3
+Status: install ok installed
+Priority: optional
+Architecture: amd64
+Source: openssl
+Version: 3.0.11-1~deb12u2
+Description: Secure Sockets Layer toolkit
+ This package is part of the OpenSSL project's implementation.
+ .
+ Source: not-a-field
+
+Package: removed-pkg
+Status: deinstall ok config-files
+Architecture: all
+Version: 1.0
+
+Package: bash
+Status: install ok installed
+Architecture: amd64
+Version: 5.2.15-2+b2
+Source: bash (5.2.15-2)
+
+Package: libfoo1
+Status: install ok installed
+Architecture: arm64
+Source: foo (1.2-3)
+Version: 1.2-3+b1`
+
+	root := t.TempDir()
+	writeFixture(t, root, map[string]string{"status": status})
+	packages, err := readDpkgStatus(filepath.Join(root, "status"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*proto.Package{
+		{Name: "libssl3", Version: "3.0.11-1~deb12u2", Arch: "amd64", Manager: "dpkg", Source: "openssl"},
+		{Name: "bash", Version: "5.2.15-2+b2", Arch: "amd64", Manager: "dpkg"},
+		{Name: "libfoo1", Version: "1.2-3+b1", Arch: "arm64", Manager: "dpkg", Source: "foo"},
+	}
+	if len(packages) != len(want) {
+		t.Fatalf("得到 %d 个软件包，期望 %d 个: %v", len(packages), len(want), packages)
+	}
+	for i := range want {
+		if !protobuf.Equal(packages[i], want[i]) {
+			t.Errorf("第 %d 个软件包 = %v, 期望 %v", i, packages[i], want[i])
+		}
+	}
+
+	if _, err := readDpkgStatus(filepath.Join(root, "missing")); err == nil {
+		t.Fatal("文件不存在时未返回错误")
+	}
+}
+
+func TestRPMSourceName(t *testing.T) {
+	tests := []struct {
+		sourceRPM string
+		name      string
+		want      string
+	}{
+		{"openssl-3.0.7-24.el9.src.rpm", "openssl-libs", "openssl"},
+		{"openssl-3.0.7-24.el9.src.rpm", "openssl", ""},
+		{"python-requests-2.25.1-8.el9.src.rpm", "python3-requests", "python-requests"},
+		{"(none)", "gpg-pubkey", ""},
+		{"", "kernel", ""},
+		{"broken.src.rpm", "broken", ""},
+		{"x-1.src.rpm", "y", ""},
+	}
+
+	for _, tt := range tests {
+		if got := rpmSourceName(tt.sourceRPM, tt.name); got != tt.want {
+			t.Errorf("rpmSourceName(%q, %q) = %q, 期望 %q", tt.sourceRPM, tt.name, got, tt.want)
+		}
+	}
+}
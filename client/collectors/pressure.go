@@ -0,0 +1,108 @@
+package collectors
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"GoMonitor/proto"
+)
+
+// DefaultPressureRoot 是内核导出PSI数据的目录
+const DefaultPressureRoot = "/proc/pressure"
+
+// PressureCollector 采集CPU、内存和IO的资源压力（PSI）
+// 内核不支持PSI（低于4.20或未启用CONFIG_PSI）时不上报，也不视为错误
+type PressureCollector struct {
+	root string
+}
+
+// NewPressureCollector 创建资源压力采集器，root为空时使用DefaultPressureRoot
+func NewPressureCollector(root string) *PressureCollector {
+	if root == "" {
+		root = DefaultPressureRoot
+	}
+	return &PressureCollector{root: root}
+}
+
+// Name 返回采集器名称
+func (c *PressureCollector) Name() string {
+	return "pressure"
+}
+
+// Collect 实现Collector接口
+func (c *PressureCollector) Collect(ctx context.Context, opts Options, info *proto.SystemInfo) error {
+	if _, err := os.Stat(c.root); os.IsNotExist(err) {
+		return nil
+	}
+
+	pressure := &proto.PressureInfo{}
+	var err error
+
+	if pressure.Cpu, err = readPressureFile(filepath.Join(c.root, "cpu")); err != nil {
+		return err
+	}
+	if pressure.Memory, err = readPressureFile(filepath.Join(c.root, "memory")); err != nil {
+		return err
+	}
+	if pressure.Io, err = readPressureFile(filepath.Join(c.root, "io")); err != nil {
+		return err
+	}
+
+	info.PressureInfo = pressure
+	return nil
+}
+
+// readPressureFile 解析PSI文件，格式如下：
+//
+//	some avg10=0.00 avg60=0.00 avg300=0.00 total=0
+//	full avg10=0.00 avg60=0.00 avg300=0.00 total=0
+//
+// 较旧的内核中cpu文件没有full行
+func readPressureFile(path string) (*proto.PressureStall, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	stall := &proto.PressureStall{}
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		values := make(map[string]string)
+		for _, field := range fields[1:] {
+			key, value, found := strings.Cut(field, "=")
+			if !found {
+				return nil, fmt.Errorf("%s 格式错误: %q", path, scanner.Text())
+			}
+			values[key] = value
+		}
+
+		avg10, err10 := strconv.ParseFloat(values["avg10"], 64)
+		avg60, err60 := strconv.ParseFloat(values["avg60"], 64)
+		avg300, err300 := strconv.ParseFloat(values["avg300"], 64)
+		total, errTotal := strconv.ParseInt(values["total"], 10, 64)
+		if err10 != nil || err60 != nil || err300 != nil || errTotal != nil {
+			return nil, fmt.Errorf("%s 格式错误: %q", path, scanner.Text())
+		}
+
+		switch fields[0] {
+		case "some":
+			stall.SomeAvg10, stall.SomeAvg60, stall.SomeAvg300, stall.SomeTotalUs = avg10, avg60, avg300, total
+		case "full":
+			stall.FullAvg10, stall.FullAvg60, stall.FullAvg300, stall.FullTotalUs = avg10, avg60, avg300, total
+		}
+	}
+
+	return stall, scanner.Err()
+}
@@ -0,0 +1,75 @@
+package collectors
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"GoMonitor/proto"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+func TestReadPressureFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    *proto.PressureStall
+		wantErr bool
+	}{
+		{
+			name:    "some和full",
+			content: "some avg10=1.50 avg60=0.75 avg300=0.10 total=123456\nfull avg10=0.50 avg60=0.25 avg300=0.00 total=654\n",
+			want: &proto.PressureStall{
+				SomeAvg10: 1.5, SomeAvg60: 0.75, SomeAvg300: 0.1, SomeTotalUs: 123456,
+				FullAvg10: 0.5, FullAvg60: 0.25, FullTotalUs: 654,
+			},
+		},
+		{
+			name:    "旧内核的cpu文件没有full行",
+			content: "some avg10=0.00 avg60=0.02 avg300=0.05 total=42\n",
+			want:    &proto.PressureStall{SomeAvg60: 0.02, SomeAvg300: 0.05, SomeTotalUs: 42},
+		},
+		{name: "空文件", content: "", want: &proto.PressureStall{}},
+		{name: "缺少等号", content: "some avg10 avg60=0 avg300=0 total=0\n", wantErr: true},
+		{name: "缺少字段", content: "some avg10=0.00 avg60=0.00 total=0\n", wantErr: true},
+		{name: "数值无效", content: "full avg10=x avg60=0 avg300=0 total=0\n", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			writeFixture(t, root, map[string]string{"cpu": tt.content})
+
+			got, err := readPressureFile(filepath.Join(root, "cpu"))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, 期望出错: %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !protobuf.Equal(got, tt.want) {
+				t.Fatalf("readPressureFile = %v, 期望 %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPressureCollector(t *testing.T) {
+	line := "some avg10=1.00 avg60=2.00 avg300=3.00 total=4\nfull avg10=5.00 avg60=6.00 avg300=7.00 total=8\n"
+
+	root := t.TempDir()
+	writeFixture(t, root, map[string]string{"cpu": line, "memory": line, "io": line})
+	info := &proto.SystemInfo{}
+	if err := NewPressureCollector(root).Collect(context.Background(), Options{}, info); err != nil {
+		t.Fatal(err)
+	}
+	if info.PressureInfo.GetIo().GetFullAvg300() != 7 || info.PressureInfo.GetCpu().GetSomeTotalUs() != 4 {
+		t.Fatalf("PressureInfo = %v", info.PressureInfo)
+	}
+
+	// 内核不支持PSI时不上报
+	info = &proto.SystemInfo{}
+	if err := NewPressureCollector(filepath.Join(root, "missing")).Collect(context.Background(), Options{}, info); err != nil {
+		t.Fatal(err)
+	}
+	if info.PressureInfo != nil {
+		t.Fatalf("PressureInfo = %v", info.PressureInfo)
+	}
+}
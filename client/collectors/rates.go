@@ -0,0 +1,89 @@
+package collectors
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/shirou/gopsutil/host"
+)
+
+// rateTracker 保存各对象（设备、接口）上一次的累计计数器，按差值计算每秒速率
+// 计数器回绕和重置（设备重建、系统重启）时不会产生负数或异常大的速率
+type rateTracker struct {
+	mu       sync.Mutex
+	prev     map[string][]uint64
+	prevAt   time.Time
+	bootTime uint64
+}
+
+// counterRates 是某个对象在统计窗口内各计数器的增量
+type counterRates struct {
+	deltas  []uint64
+	elapsed time.Duration
+}
+
+// perSecond 返回第i个计数器的每秒速率
+func (r counterRates) perSecond(i int) float64 {
+	if r.elapsed <= 0 {
+		return 0
+	}
+	return float64(r.deltas[i]) / r.elapsed.Seconds()
+}
+
+// newRateTracker 创建速率计算器
+func newRateTracker() *rateTracker {
+	return &rateTracker{
+		prev: make(map[string][]uint64),
+	}
+}
+
+// Update 用当前计数器计算自上一次基准以来的增量，同时返回统计窗口长度
+// 没有上一次数据的对象不会出现在结果中；advance为false时不更新基准
+func (t *rateTracker) Update(ctx context.Context, current map[string][]uint64, advance bool) (map[string]counterRates, time.Duration) {
+	now := time.Now()
+	bootTime, _ := host.BootTimeWithContext(ctx)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	rates := make(map[string]counterRates)
+	var elapsed time.Duration
+
+	// 系统重启后所有计数器从零开始，上一次的基准失效
+	if bootTime == t.bootTime && !t.prevAt.IsZero() {
+		elapsed = now.Sub(t.prevAt)
+		for name, values := range current {
+			prev, ok := t.prev[name]
+			if !ok || len(prev) != len(values) {
+				continue
+			}
+
+			deltas := make([]uint64, len(values))
+			for i := range values {
+				deltas[i] = counterDelta(prev[i], values[i])
+			}
+			rates[name] = counterRates{deltas: deltas, elapsed: elapsed}
+		}
+	}
+
+	if advance || t.prevAt.IsZero() {
+		t.prev = current
+		t.prevAt = now
+		t.bootTime = bootTime
+	}
+	return rates, elapsed
+}
+
+// counterDelta 计算累计计数器的增量
+// 计数器变小时，若上一次的值接近32位上限则按回绕处理，否则视为计数器已重置，增量为当前值
+func counterDelta(prev, cur uint64) uint64 {
+	if cur >= prev {
+		return cur - prev
+	}
+	if prev <= math.MaxUint32 && prev > math.MaxUint32/2 {
+		return cur + (math.MaxUint32 - prev) + 1
+	}
+	return cur
+}
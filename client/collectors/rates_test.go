@@ -0,0 +1,63 @@
+package collectors
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestCounterDelta(t *testing.T) {
+	tests := []struct {
+		name      string
+		prev, cur uint64
+		want      uint64
+	}{
+		{"递增", 100, 250, 150},
+		{"不变", 100, 100, 0},
+		{"32位回绕", math.MaxUint32 - 9, 5, 15},
+		{"32位上限处回绕到0", math.MaxUint32, 0, 1},
+		{"较小的值变小视为重置", 1000, 10, 10},
+		{"64位计数器变小视为重置", math.MaxUint32 + 100, 50, 50},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := counterDelta(tt.prev, tt.cur); got != tt.want {
+				t.Fatalf("counterDelta(%d, %d) = %d, 期望 %d", tt.prev, tt.cur, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRateTracker(t *testing.T) {
+	ctx := context.Background()
+	tracker := newRateTracker()
+
+	rates, _ := tracker.Update(ctx, map[string][]uint64{"sda": {100, 200}}, true)
+	if len(rates) != 0 {
+		t.Fatalf("首次采样不应有速率: %v", rates)
+	}
+
+	tracker.prevAt = tracker.prevAt.Add(-2 * time.Second)
+	rates, elapsed := tracker.Update(ctx, map[string][]uint64{"sda": {300, 150}, "sdb": {1, 1}}, false)
+	sda, ok := rates["sda"]
+	if !ok || elapsed < 2*time.Second {
+		t.Fatalf("rates = %v, elapsed = %v", rates, elapsed)
+	}
+	if sda.deltas[0] != 200 || sda.deltas[1] != 150 {
+		t.Fatalf("sda增量 = %v", sda.deltas)
+	}
+	if _, ok := rates["sdb"]; ok {
+		t.Fatal("新出现的对象不应有速率")
+	}
+	if got := sda.perSecond(0); got <= 0 || got > 100 {
+		t.Fatalf("每秒速率 = %v", got)
+	}
+
+	// advance为false时基准不变
+	rates, _ = tracker.Update(ctx, map[string][]uint64{"sda": {400, 300}}, true)
+	if rates["sda"].deltas[0] != 300 {
+		t.Fatalf("按需查询改变了基准: %v", rates["sda"].deltas)
+	}
+}
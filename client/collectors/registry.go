@@ -0,0 +1,243 @@
+package collectors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"GoMonitor/proto"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// DefaultTimeout 是未单独配置超时时间的采集器所使用的超时
+const DefaultTimeout = 30 * time.Second
+
+// Collector 是所有采集器需要实现的接口
+// Collect 只应填充info中属于自己的字段，注册表会把各采集器的结果合并为完整的SystemInfo
+type Collector interface {
+	Name() string
+	Collect(ctx context.Context, opts Options, info *proto.SystemInfo) error
+}
+
+// Settings 单个采集器的运行参数
+type Settings struct {
+	Enabled  bool
+	Interval time.Duration // 两次采集的最小间隔，0表示每次上报都重新采集
+	Timeout  time.Duration // 单次采集的超时时间，0表示使用DefaultTimeout
+}
+
+// cachedResult 保存采集器最近一次成功的结果，用于采集间隔大于上报间隔的场景
+type cachedResult struct {
+	info        *proto.SystemInfo
+	collectedAt time.Time
+}
+
+// Registry 管理所有采集器并负责并行执行
+type Registry struct {
+	mu         sync.Mutex
+	collectors []Collector
+	settings   map[string]Settings
+	cache      map[string]*cachedResult
+}
+
+// NewRegistry 创建空的采集器注册表
+func NewRegistry() *Registry {
+	return &Registry{
+		settings: make(map[string]Settings),
+		cache:    make(map[string]*cachedResult),
+	}
+}
+
+// NewDefaultRegistry 创建包含所有内置采集器的注册表，默认全部启用
+func NewDefaultRegistry() *Registry {
+	r := NewRegistry()
+	r.Register(NewCPUCollector())
+	r.Register(&MemoryCollector{})
+	r.Register(NewDiskCollector())
+	r.Register(NewNetworkCollector())
+	r.Register(NewPressureCollector(""))
+	r.Register(NewConnectionCollector(""))
+	return r
+}
+
+// Register 注册采集器，同名采集器会被替换
+func (r *Registry) Register(c Collector) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, existing := range r.collectors {
+		if existing.Name() == c.Name() {
+			r.collectors[i] = c
+			delete(r.cache, c.Name())
+			return
+		}
+	}
+
+	r.collectors = append(r.collectors, c)
+	r.settings[c.Name()] = Settings{Enabled: true}
+}
+
+// Configure 更新采集器的运行参数
+func (r *Registry) Configure(name string, settings Settings) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if old, ok := r.settings[name]; ok && old.Interval != settings.Interval {
+		delete(r.cache, name)
+	}
+	r.settings[name] = settings
+}
+
+// Invalidate 丢弃采集器的缓存结果，下一次上报时重新采集
+func (r *Registry) Invalidate(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.cache, name)
+}
+
+// Names 返回已注册采集器的名称
+func (r *Registry) Names() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	names := make([]string, len(r.collectors))
+	for i, c := range r.collectors {
+		names[i] = c.Name()
+	}
+	return names
+}
+
+// Get 按名称查找采集器
+func (r *Registry) Get(name string) (Collector, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, c := range r.collectors {
+		if c.Name() == name {
+			return c, true
+		}
+	}
+	return nil, false
+}
+
+// CollectAll 并行运行所有启用的采集器并合并结果
+// 单个采集器失败或超时不会影响其他采集器，错误记录在返回结果的CollectorErrors中
+func (r *Registry) CollectAll(ctx context.Context, opts Options) *proto.SystemInfo {
+	type job struct {
+		collector Collector
+		settings  Settings
+		cached    *proto.SystemInfo
+	}
+
+	r.mu.Lock()
+	now := time.Now()
+	jobs := make([]job, 0, len(r.collectors))
+	for _, c := range r.collectors {
+		settings := r.settings[c.Name()]
+		if !settings.Enabled {
+			continue
+		}
+
+		j := job{collector: c, settings: settings}
+		if cached, ok := r.cache[c.Name()]; ok && now.Sub(cached.collectedAt) < settings.Interval {
+			j.cached = cached.info
+		}
+		jobs = append(jobs, j)
+	}
+	r.mu.Unlock()
+
+	results := make([]*proto.SystemInfo, len(jobs))
+	errs := make([]error, len(jobs))
+
+	var wg sync.WaitGroup
+	for i, j := range jobs {
+		if j.cached != nil {
+			results[i] = j.cached
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, j job) {
+			defer wg.Done()
+			results[i], errs[i] = r.run(ctx, j.collector, j.settings.Timeout, opts)
+		}(i, j)
+	}
+	wg.Wait()
+
+	sysInfo := &proto.SystemInfo{}
+	for i, j := range jobs {
+		if errs[i] != nil {
+			sysInfo.CollectorErrors = append(sysInfo.CollectorErrors, &proto.CollectorError{
+				Collector: j.collector.Name(),
+				Error:     errs[i].Error(),
+				Timestamp: now.Unix(),
+			})
+			continue
+		}
+
+		if j.cached == nil && !opts.Peek {
+			r.mu.Lock()
+			r.cache[j.collector.Name()] = &cachedResult{info: results[i], collectedAt: now}
+			r.mu.Unlock()
+		}
+
+		protobuf.Merge(sysInfo, results[i])
+	}
+
+	return sysInfo
+}
+
+// Collect 立即运行指定的采集器，不使用缓存
+func (r *Registry) Collect(ctx context.Context, name string, opts Options) (*proto.SystemInfo, error) {
+	c, ok := r.Get(name)
+	if !ok {
+		return nil, fmt.Errorf("未知的采集器: %s", name)
+	}
+
+	r.mu.Lock()
+	timeout := r.settings[name].Timeout
+	r.mu.Unlock()
+
+	return r.run(ctx, c, timeout, opts)
+}
+
+// run 在超时控制下运行采集器
+// 采集器写入独立的SystemInfo，超时后即使采集goroutine仍在运行也不会影响返回结果
+func (r *Registry) run(ctx context.Context, c Collector, timeout time.Duration, opts Options) (*proto.SystemInfo, error) {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	type outcome struct {
+		info *proto.SystemInfo
+		err  error
+	}
+	done := make(chan outcome, 1)
+
+	go func() {
+		defer func() {
+			if p := recover(); p != nil {
+				done <- outcome{err: fmt.Errorf("采集器 %s 崩溃: %v", c.Name(), p)}
+			}
+		}()
+
+		info := &proto.SystemInfo{}
+		err := c.Collect(ctx, opts, info)
+		done <- outcome{info: info, err: err}
+	}()
+
+	select {
+	case o := <-done:
+		return o.info, o.err
+	case <-ctx.Done():
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, fmt.Errorf("采集器 %s 超时（%v）", c.Name(), timeout)
+		}
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,214 @@
+package collectors
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"GoMonitor/proto"
+)
+
+// systemctlTimeout 是单次调用systemctl的超时
+const systemctlTimeout = 10 * time.Second
+
+// systemdRuntimeDir 仅在以systemd作为init启动的系统中存在，判断方式与sd_booted()一致
+const systemdRuntimeDir = "/run/systemd/system"
+
+// unitProperties 是查询单元状态时读取的属性
+var unitProperties = []string{"Id", "Description", "LoadState", "ActiveState", "SubState", "UnitFileState", "MainPID", "NRestarts"}
+
+// unitNamePattern 限制单元名称的字符，同时防止以"-"开头被systemctl当作选项
+var unitNamePattern = regexp.MustCompile(`^[A-Za-z0-9:_.@\\][A-Za-z0-9:_.@\\-]*$`)
+
+// ServiceActions 是service命令支持的操作
+var ServiceActions = []string{"start", "stop", "restart", "reload", "status"}
+
+// SystemdCollector 上报配置的systemd单元以及所有失败单元的状态
+// 未以systemd启动的系统（非systemd发行版、容器内）不上报，也不视为错误
+type SystemdCollector struct {
+	units         []string
+	includeFailed bool
+}
+
+// NewSystemdCollector 创建systemd单元采集器，includeFailed为true时额外上报所有处于failed状态的单元
+func NewSystemdCollector(units []string, includeFailed bool) *SystemdCollector {
+	return &SystemdCollector{
+		units:         units,
+		includeFailed: includeFailed,
+	}
+}
+
+// Name 返回采集器名称
+func (c *SystemdCollector) Name() string {
+	return "systemd"
+}
+
+// Collect 实现Collector接口
+func (c *SystemdCollector) Collect(ctx context.Context, opts Options, info *proto.SystemInfo) error {
+	if _, err := os.Stat(systemdRuntimeDir); err != nil {
+		return nil
+	}
+
+	names := append([]string{}, c.units...)
+	if c.includeFailed {
+		failed, err := failedUnits(ctx)
+		if err != nil {
+			return err
+		}
+		for _, name := range failed {
+			if !containsString(names, name) {
+				names = append(names, name)
+			}
+		}
+	}
+
+	if len(names) == 0 {
+		return nil
+	}
+
+	units, err := QueryUnits(ctx, names)
+	if err != nil {
+		return err
+	}
+
+	info.ServiceUnits = units
+	return nil
+}
+
+// failedUnits 返回所有处于failed状态的单元名称
+func failedUnits(ctx context.Context) ([]string, error) {
+	result, err := runExternal(ctx, systemctlTimeout, "systemctl",
+		[]string{"list-units", "--state=failed", "--plain", "--no-legend", "--no-pager", "--full"})
+	if err != nil {
+		return nil, fmt.Errorf("查询失败单元出错: %w", err)
+	}
+	if result.ExitCode != 0 {
+		return nil, fmt.Errorf("查询失败单元出错: systemctl退出码 %d: %s", result.ExitCode, result.Stderr)
+	}
+
+	var names []string
+	scanner := bufio.NewScanner(bytes.NewReader(result.Stdout))
+	for scanner.Scan() {
+		if fields := strings.Fields(scanner.Text()); len(fields) > 0 {
+			names = append(names, fields[0])
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// QueryUnits 查询单元的当前状态，结果顺序与names一致
+// 不存在的单元不会报错，其LoadState为not-found
+func QueryUnits(ctx context.Context, names []string) ([]*proto.ServiceUnit, error) {
+	for _, name := range names {
+		if err := ValidateUnitName(name); err != nil {
+			return nil, err
+		}
+	}
+
+	args := append([]string{"show", "--no-pager", "--property=" + strings.Join(unitProperties, ",")}, names...)
+	result, err := runExternal(ctx, systemctlTimeout, "systemctl", args)
+	if err != nil {
+		return nil, fmt.Errorf("查询单元状态出错: %w", err)
+	}
+	if result.ExitCode != 0 {
+		return nil, fmt.Errorf("查询单元状态出错: systemctl退出码 %d: %s", result.ExitCode, result.Stderr)
+	}
+
+	units := parseUnitProperties(result.Stdout)
+	if len(units) != len(names) {
+		return nil, fmt.Errorf("查询单元状态出错: 请求 %d 个单元，systemctl返回 %d 个", len(names), len(units))
+	}
+	// 属性Id是单元的规范名称（例如补全了.service后缀），保留配置中的写法便于对应
+	for i, unit := range units {
+		unit.Name = names[i]
+	}
+	return units, nil
+}
+
+// parseUnitProperties 解析 systemctl show 的输出，每个单元一段 "Key=Value"，段之间以空行分隔
+func parseUnitProperties(output []byte) []*proto.ServiceUnit {
+	var units []*proto.ServiceUnit
+	var current *proto.ServiceUnit
+
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			current = nil
+			continue
+		}
+		if current == nil {
+			current = &proto.ServiceUnit{}
+			units = append(units, current)
+		}
+
+		key, value, _ := strings.Cut(line, "=")
+		switch key {
+		case "Id":
+			current.Id = value
+		case "Description":
+			current.Description = value
+		case "LoadState":
+			current.LoadState = value
+		case "ActiveState":
+			current.ActiveState = value
+		case "SubState":
+			current.SubState = value
+		case "UnitFileState":
+			current.UnitFileState = value
+		case "MainPID":
+			current.MainPid, _ = strconv.ParseInt(value, 10, 64)
+		case "NRestarts":
+			current.Restarts, _ = strconv.ParseInt(value, 10, 64)
+		}
+	}
+	return units
+}
+
+// ControlUnit 对单元执行start、stop、restart或reload操作，返回systemctl的退出码和输出
+// systemctl非零退出时不返回错误，调用方根据退出码判断；无法执行systemctl或超时时返回错误
+func ControlUnit(ctx context.Context, action string, unit string, timeout time.Duration) (int, string, error) {
+	if !containsString(ServiceActions, action) || action == "status" {
+		return 0, "", fmt.Errorf("不支持的操作: %s", action)
+	}
+	if err := ValidateUnitName(unit); err != nil {
+		return 0, "", err
+	}
+
+	result, err := runExternal(ctx, timeout, "systemctl", []string{action, "--no-pager", unit})
+	if err != nil {
+		return 0, "", err
+	}
+
+	output := strings.TrimSpace(string(result.Stdout))
+	if result.Stderr != "" {
+		output = strings.TrimSpace(output + "\n" + result.Stderr)
+	}
+	return result.ExitCode, output, nil
+}
+
+// ValidateUnitName 校验单元名称，只允许systemd单元名中合法的字符
+func ValidateUnitName(name string) error {
+	if !unitNamePattern.MatchString(name) {
+		return fmt.Errorf("无效的单元名称: %q", name)
+	}
+	return nil
+}
+
+// containsString 判断items中是否包含item
+func containsString(items []string, item string) bool {
+	for _, i := range items {
+		if i == item {
+			return true
+		}
+	}
+	return false
+}
@@ -2,13 +2,18 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net"
 	"os/exec"
 	"runtime"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
-	"GoMonitor/client/collectors"
+	"GoMonitor/pkg/models"
+	"GoMonitor/pkg/utils"
 	"GoMonitor/proto"
 )
 
@@ -28,7 +33,7 @@ func NewCommandExecutor(client *Client) *CommandExecutor {
 func (ce *CommandExecutor) ExecuteCommand(cmd *proto.Command) {
 	startTime := time.Now()
 	result := &proto.CommandResult{
-		ClientId:    ce.client.clientID,
+		ClientId:    ce.client.ClientID(),
 		CommandId:   cmd.CommandId,
 		Success:     false,
 		Output:      "",
@@ -58,6 +63,14 @@ func (ce *CommandExecutor) ExecuteCommand(cmd *proto.Command) {
 		"shell":        ce.ExecuteShellCommand,
 		"collect_info": ce.ExecuteCollectInfoCommand,
 		"update":       ce.ExecuteUpdateCommand,
+		"service":      ce.ExecuteServiceCommand,
+		"integrity":    ce.ExecuteIntegrityCommand,
+	}
+
+	if !ce.client.CommandAllowed(cmd.CommandType) {
+		result.Success = false
+		result.Error = fmt.Sprintf("命令类型 %s 被命令策略禁止", cmd.CommandType)
+		return
 	}
 
 	if handler, exists := commandHandlers[cmd.CommandType]; exists {
@@ -81,6 +94,10 @@ func (ce *CommandExecutor) ExecuteShellCommand(ctx context.Context, cmd *proto.C
 
 	output, err := execCmd.CombinedOutput()
 	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			result.ExitCode = int32(exitErr.ExitCode())
+		}
 		result.Success = false
 		result.Error = err.Error()
 		result.Output = string(output)
@@ -91,107 +108,319 @@ func (ce *CommandExecutor) ExecuteShellCommand(ctx context.Context, cmd *proto.C
 	result.Output = string(output)
 }
 
-// ExecuteCollectInfoCommand 执行信息收集命令
-func (ce *CommandExecutor) ExecuteCollectInfoCommand(ctx context.Context, cmd *proto.Command, result *proto.CommandResult) {
-	infoType := cmd.Content
-	var output string
-	var err error
-
-	infoCollectors := map[string]func() (string, error){
-		"cpu": func() (string, error) {
-			cpuInfo, err := collectors.CollectCPUInfo()
-			if err != nil {
-				return "", err
+// infoFormatters 将单个采集器的结果格式化为可读文本，键为collect_info命令的内容
+var infoFormatters = map[string]func(*proto.SystemInfo) string{
+	"cpu": func(info *proto.SystemInfo) string {
+		cpuInfo := info.GetCpuInfo()
+
+		var sb strings.Builder
+		sb.WriteString(fmt.Sprintf("CPU型号: %s @ %.0f MHz\n", cpuInfo.GetModelName(), cpuInfo.GetMhz()))
+		sb.WriteString(fmt.Sprintf("核心数: %d 颗CPU, %d 物理核心, %d 逻辑核心\n",
+			cpuInfo.GetSockets(), cpuInfo.GetPhysicalCores(), cpuInfo.GetLogicalCores()))
+		sb.WriteString(fmt.Sprintf("CPU使用率: %.2f%% (最近 %.0f 秒)\n",
+			cpuInfo.GetCpuUsagePercent(), cpuInfo.GetSampleSeconds()))
+		sb.WriteString(fmt.Sprintf("用户态: %.2f%%, 内核态: %.2f%%, I/O等待: %.2f%%, 被抢占: %.2f%%, 空闲: %.2f%%\n",
+			cpuInfo.GetUserPercent(), cpuInfo.GetSystemPercent(),
+			cpuInfo.GetIowaitPercent(), cpuInfo.GetStealPercent(), cpuInfo.GetIdlePercent()))
+		sb.WriteString(fmt.Sprintf("平均负载: %.2f, %.2f, %.2f\n",
+			cpuInfo.GetLoad_1M(), cpuInfo.GetLoad_5M(), cpuInfo.GetLoad_15M()))
+
+		for i, coreUsage := range cpuInfo.GetCoreUsagePercents() {
+			sb.WriteString(fmt.Sprintf("核心 %d 使用率: %.2f%%\n", i, coreUsage))
+		}
+
+		return sb.String()
+	},
+
+	"memory": func(info *proto.SystemInfo) string {
+		memInfo := info.GetMemoryInfo()
+
+		return fmt.Sprintf(
+			"内存使用率: %.2f%%\n总内存: %d MB\n已用内存: %d MB\n可用内存: %d MB\n空闲内存: %d MB\n"+
+				"缓冲区: %d MB\n缓存: %d MB\n共享: %d MB\n脏页: %d MB\n回写中: %d MB\nSlab: %d MB (可回收 %d MB)\n"+
+				"大页: %d/%d 空闲, 每页 %d KB\n交换分区: %d/%d MB\n",
+			memInfo.GetMemoryUsagePercent(),
+			memInfo.GetTotalMemory()/(1024*1024),
+			memInfo.GetUsedMemory()/(1024*1024),
+			memInfo.GetAvailableMemory()/(1024*1024),
+			memInfo.GetFreeMemory()/(1024*1024),
+			memInfo.GetBuffers()/(1024*1024),
+			memInfo.GetCached()/(1024*1024),
+			memInfo.GetShared()/(1024*1024),
+			memInfo.GetDirty()/(1024*1024),
+			memInfo.GetWriteback()/(1024*1024),
+			memInfo.GetSlab()/(1024*1024),
+			memInfo.GetSlabReclaimable()/(1024*1024),
+			memInfo.GetHugepagesFree(), memInfo.GetHugepagesTotal(), memInfo.GetHugepageSize()/1024,
+			memInfo.GetSwapUsed()/(1024*1024), memInfo.GetSwapTotal()/(1024*1024))
+	},
+
+	"pressure": func(info *proto.SystemInfo) string {
+		pressure := info.GetPressureInfo()
+		if pressure == nil {
+			return "系统不支持资源压力统计（PSI）\n"
+		}
+
+		var sb strings.Builder
+		for _, item := range []struct {
+			name  string
+			stall *proto.PressureStall
+		}{{"CPU", pressure.Cpu}, {"内存", pressure.Memory}, {"IO", pressure.Io}} {
+			sb.WriteString(fmt.Sprintf("%s压力: some %.2f/%.2f/%.2f, full %.2f/%.2f/%.2f (10秒/60秒/300秒)\n",
+				item.name,
+				item.stall.GetSomeAvg10(), item.stall.GetSomeAvg60(), item.stall.GetSomeAvg300(),
+				item.stall.GetFullAvg10(), item.stall.GetFullAvg60(), item.stall.GetFullAvg300()))
+		}
+		return sb.String()
+	},
+
+	"cgroups": func(info *proto.SystemInfo) string {
+		var sb strings.Builder
+		sb.WriteString(fmt.Sprintf("容器和slice数: %d\n", len(info.Containers)))
+		for _, container := range info.Containers {
+			sb.WriteString(formatContainer(container))
+		}
+		for _, collectorErr := range info.CollectorErrors {
+			sb.WriteString(fmt.Sprintf("%s 失败: %s\n", collectorErr.Collector, collectorErr.Error))
+		}
+
+		return sb.String()
+	},
+
+	"systemd": func(info *proto.SystemInfo) string {
+		var sb strings.Builder
+		sb.WriteString(fmt.Sprintf("单元数: %d\n", len(info.ServiceUnits)))
+		for _, unit := range info.ServiceUnits {
+			sb.WriteString(formatServiceUnit(unit))
+		}
+
+		return sb.String()
+	},
+
+	"connections": func(info *proto.SystemInfo) string {
+		connInfo := info.GetConnectionInfo()
+
+		var sb strings.Builder
+		sb.WriteString(fmt.Sprintf("监听端口数: %d\n", len(connInfo.GetListening())))
+		for _, socket := range connInfo.GetListening() {
+			sb.WriteString(fmt.Sprintf("  %-5s %s 进程: %s (%d)\n", socket.Protocol,
+				net.JoinHostPort(socket.Address, strconv.Itoa(int(socket.Port))), socket.Process, socket.Pid))
+		}
+
+		states := make([]string, 0, len(connInfo.GetTcpStates()))
+		for state := range connInfo.GetTcpStates() {
+			states = append(states, state)
+		}
+		sort.Strings(states)
+		sb.WriteString("TCP连接状态:")
+		for _, state := range states {
+			sb.WriteString(fmt.Sprintf(" %s=%d", state, connInfo.TcpStates[state]))
+		}
+		sb.WriteString("\n")
+
+		sb.WriteString(fmt.Sprintf("TCP连接 (最多显示 %d 条):\n", len(connInfo.GetConnections())))
+		for _, conn := range connInfo.GetConnections() {
+			sb.WriteString(fmt.Sprintf("  %-5s %s -> %s %s 进程: %s (%d)\n", conn.Protocol,
+				net.JoinHostPort(conn.LocalAddress, strconv.Itoa(int(conn.LocalPort))),
+				net.JoinHostPort(conn.RemoteAddress, strconv.Itoa(int(conn.RemotePort))),
+				conn.State, conn.Process, conn.Pid))
+		}
+
+		return sb.String()
+	},
+
+	"packages": func(info *proto.SystemInfo) string {
+		packages := info.GetPackages().GetPackages()
+
+		counts := make(map[string]int)
+		for _, pkg := range packages {
+			counts[pkg.Manager]++
+		}
+		managers := make([]string, 0, len(counts))
+		for manager := range counts {
+			managers = append(managers, manager)
+		}
+		sort.Strings(managers)
+
+		var sb strings.Builder
+		sb.WriteString(fmt.Sprintf("已安装软件包数: %d", len(packages)))
+		for _, manager := range managers {
+			sb.WriteString(fmt.Sprintf(" %s=%d", manager, counts[manager]))
+		}
+		sb.WriteString("\n")
+		for _, pkg := range packages {
+			sb.WriteString(fmt.Sprintf("  %-5s %s %s %s\n", pkg.Manager, pkg.Name, pkg.Version, pkg.Arch))
+		}
+		return sb.String()
+	},
+
+	"integrity": func(info *proto.SystemInfo) string {
+		return formatIntegrityReport(info.GetIntegrity())
+	},
+
+	"logins": func(info *proto.SystemInfo) string {
+		return models.FormatLoginInfo(info.GetLogins())
+	},
+
+	"accounts": func(info *proto.SystemInfo) string {
+		return models.FormatAccountInventory(info.GetAccounts())
+	},
+
+	"cron": func(info *proto.SystemInfo) string {
+		return models.FormatScheduledJobs(info.GetScheduledJobs())
+	},
+
+	"disk": func(info *proto.SystemInfo) string {
+		partitions := info.GetDiskInfo().GetPartitions()
+
+		var sb strings.Builder
+		sb.WriteString(fmt.Sprintf("磁盘分区数: %d\n", len(partitions)))
+
+		for i, partition := range partitions {
+			sb.WriteString(fmt.Sprintf(
+				"分区 %d: %s, 总空间: %d GB, 已用: %.2f%%\n",
+				i+1,
+				partition.MountPoint,
+				partition.TotalSpace/(1024*1024*1024),
+				partition.UsagePercent))
+			if partition.InodesTotal > 0 {
+				sb.WriteString(fmt.Sprintf("  inode: %d/%d, 已用: %.2f%%\n",
+					partition.InodesUsed, partition.InodesTotal, partition.InodesUsagePercent))
 			}
-
-			var sb strings.Builder
-			sb.WriteString(fmt.Sprintf("CPU使用率: %.2f%%\n核心数: %d\n",
-				cpuInfo.CpuUsagePercent, cpuInfo.CpuCores))
-
-			for i, coreUsage := range cpuInfo.CoreUsagePercents {
-				sb.WriteString(fmt.Sprintf("核心 %d 使用率: %.2f%%\n", i, coreUsage))
-			}
-
-			return sb.String(), nil
-		},
-
-		"memory": func() (string, error) {
-			memInfo, err := collectors.CollectMemoryInfo()
-			if err != nil {
-				return "", err
-			}
-
-			return fmt.Sprintf(
-				"内存使用率: %.2f%%\n总内存: %d MB\n已用内存: %d MB\n可用内存: %d MB\n",
-				memInfo.MemoryUsagePercent,
-				memInfo.TotalMemory/(1024*1024),
-				memInfo.UsedMemory/(1024*1024),
-				memInfo.FreeMemory/(1024*1024)), nil
-		},
-
-		"disk": func() (string, error) {
-			diskInfo, err := collectors.CollectDiskInfo()
-			if err != nil {
-				return "", err
-			}
-
-			var sb strings.Builder
-			sb.WriteString(fmt.Sprintf("磁盘分区数: %d\n", len(diskInfo.Partitions)))
-
-			for i, partition := range diskInfo.Partitions {
+		}
+
+		for _, device := range info.GetDiskInfo().GetDevices() {
+			sb.WriteString(fmt.Sprintf(
+				"设备 %s: 读 %s (%.1f IOPS), 写 %s (%.1f IOPS), 平均耗时: %.2fms, 利用率: %.2f%%\n",
+				device.Name,
+				utils.FormatRate(device.ReadBytesPerSec), device.ReadsPerSec,
+				utils.FormatRate(device.WriteBytesPerSec), device.WritesPerSec,
+				device.AwaitMs, device.UtilizationPercent))
+		}
+
+		return sb.String()
+	},
+
+	"network": func(info *proto.SystemInfo) string {
+		interfaces := info.GetNetworkInfo().GetInterfaces()
+
+		var sb strings.Builder
+		sb.WriteString(fmt.Sprintf("网络接口数: %d\n", len(interfaces)))
+
+		for name, iface := range interfaces {
+			sb.WriteString(fmt.Sprintf(
+				"接口: %s, IP: %s, MAC: %s, 状态: %v\n",
+				name, models.FormatIPAddresses(iface.IpAddresses), iface.MacAddress, iface.IsUp))
+			if iface.SampleSeconds > 0 {
 				sb.WriteString(fmt.Sprintf(
-					"分区 %d: %s, 总空间: %d GB, 已用: %.2f%%\n",
-					i+1,
-					partition.MountPoint,
-					partition.TotalSpace/(1024*1024*1024),
-					partition.UsagePercent))
+					"  接收: %s (%.1f 包/秒), 发送: %s (%.1f 包/秒), 错误: %.1f/%.1f 每秒, 丢包: %.1f/%.1f 每秒\n",
+					utils.FormatRate(iface.RxBytesPerSec), iface.RxPacketsPerSec,
+					utils.FormatRate(iface.TxBytesPerSec), iface.TxPacketsPerSec,
+					iface.RxErrorsPerSec, iface.TxErrorsPerSec, iface.RxDropsPerSec, iface.TxDropsPerSec))
 			}
+		}
+
+		return sb.String()
+	},
+
+	"plugins": func(info *proto.SystemInfo) string {
+		names := make([]string, 0, len(info.CustomMetrics))
+		for name := range info.CustomMetrics {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		var sb strings.Builder
+		sb.WriteString(fmt.Sprintf("插件指标数: %d\n", len(names)))
+		for _, name := range names {
+			sb.WriteString(fmt.Sprintf("%s = %s\n", name, info.CustomMetrics[name]))
+		}
+		for _, collectorErr := range info.CollectorErrors {
+			sb.WriteString(fmt.Sprintf("%s 失败: %s\n", collectorErr.Collector, collectorErr.Error))
+		}
+
+		return sb.String()
+	},
+
+	"checks": func(info *proto.SystemInfo) string {
+		var sb strings.Builder
+		sb.WriteString(fmt.Sprintf("检查数: %d\n", len(info.CheckResults)))
+		for _, check := range info.CheckResults {
+			sb.WriteString(fmt.Sprintf("%s: %s - %s\n",
+				check.Name, models.CheckStateName(check.State), check.Output))
+		}
+
+		return sb.String()
+	},
+}
 
-			return sb.String(), nil
-		},
-
-		"network": func() (string, error) {
-			netInfo, err := collectors.CollectNetworkInfo()
-			if err != nil {
-				return "", err
-			}
-
-			var sb strings.Builder
-			sb.WriteString(fmt.Sprintf("网络接口数: %d\n", len(netInfo.Interfaces)))
-
-			for name, iface := range netInfo.Interfaces {
-				sb.WriteString(fmt.Sprintf(
-					"接口: %s, IP: %s, MAC: %s, 状态: %v\n",
-					name, iface.IpAddress, iface.MacAddress, iface.IsUp))
-			}
+// formatContainer 将单个容器或slice的资源使用格式化为两行文本，限制为0时显示"不限"
+func formatContainer(container *proto.ContainerStats) string {
+	name := container.Cgroup
+	if container.Name != "" {
+		name = fmt.Sprintf("%s (%s)", container.Name, container.Id[:12])
+	}
 
-			return sb.String(), nil
-		},
+	limit := func(value string, unlimited bool) string {
+		if unlimited {
+			return "不限"
+		}
+		return value
+	}
 
-		"all": func() (string, error) {
-			sysInfo, err := ce.client.CollectSystemInfo()
-			if err != nil {
-				return "", err
-			}
+	return fmt.Sprintf(
+		"%s [%s]: CPU %.2f%% / %s 核 (限流 %d 次), 内存 %s / %s, 进程 %d / %s\n  读 %s, 写 %s (最近 %.0f 秒)\n",
+		name, container.Runtime,
+		container.CpuUsagePercent, limit(fmt.Sprintf("%.2f", container.CpuLimitCores), container.CpuLimitCores == 0),
+		container.CpuThrottledPeriods,
+		utils.FormatBytes(container.MemoryUsage), limit(utils.FormatBytes(container.MemoryLimit), container.MemoryLimit == 0),
+		container.PidsCurrent, limit(strconv.FormatInt(container.PidsLimit, 10), container.PidsLimit == 0),
+		utils.FormatRate(container.IoReadBytesPerSec), utils.FormatRate(container.IoWriteBytesPerSec),
+		container.SampleSeconds)
+}
 
-			return fmt.Sprintf(
-				"CPU使用率: %.2f%%\n内存使用率: %.2f%%\n磁盘分区数: %d\n网络接口数: %d\n",
-				sysInfo.CpuInfo.CpuUsagePercent,
-				sysInfo.MemoryInfo.MemoryUsagePercent,
-				len(sysInfo.DiskInfo.Partitions),
-				len(sysInfo.NetworkInfo.Interfaces)), nil
-		},
-
-		"process": func() (string, error) {
-			// 这里可以添加进程列表收集功能
-			return "进程列表收集功能尚未实现", nil
-		},
-	}
+// ExecuteCollectInfoCommand 执行信息收集命令
+func (ce *CommandExecutor) ExecuteCollectInfoCommand(ctx context.Context, cmd *proto.Command, result *proto.CommandResult) {
+	infoType := cmd.Content
+	var output string
+	var err error
 
-	if collector, exists := infoCollectors[infoType]; exists {
-		output, err = collector()
-	} else {
-		err = fmt.Errorf("未知的信息类型: %s", infoType)
+	switch infoType {
+	case "all":
+		sysInfo := ce.client.CollectSystemInfo(ctx, true)
+
+		var sb strings.Builder
+		sb.WriteString(fmt.Sprintf(
+			"CPU使用率: %.2f%%\n内存使用率: %.2f%%\n磁盘分区数: %d\n网络接口数: %d\n",
+			sysInfo.GetCpuInfo().GetCpuUsagePercent(),
+			sysInfo.GetMemoryInfo().GetMemoryUsagePercent(),
+			len(sysInfo.GetDiskInfo().GetPartitions()),
+			len(sysInfo.GetNetworkInfo().GetInterfaces())))
+
+		for _, collectorErr := range sysInfo.CollectorErrors {
+			sb.WriteString(fmt.Sprintf("采集器 %s 失败: %s\n", collectorErr.Collector, collectorErr.Error))
+		}
+		output = sb.String()
+
+	case "process":
+		// 这里可以添加进程列表收集功能
+		output = "进程列表收集功能尚未实现"
+
+	default:
+		format, exists := infoFormatters[infoType]
+		if !exists {
+			err = fmt.Errorf("未知的信息类型: %s", infoType)
+			break
+		}
+
+		opts := ce.client.CollectorOptions()
+		opts.Peek = true
+
+		var sysInfo *proto.SystemInfo
+		sysInfo, err = ce.client.registry.Collect(ctx, infoType, opts)
+		if err == nil {
+			output = format(sysInfo)
+		}
 	}
 
 	if err != nil {
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"GoMonitor/pkg/models"
+	"GoMonitor/proto"
+)
+
+// ExecuteIntegrityCommand 执行integrity命令，内容格式为 "rebaseline [路径]"
+// 不指定路径时以当前状态重建整个基线；指定路径（支持通配符）时只接受该路径及其下文件的变化
+func (ce *CommandExecutor) ExecuteIntegrityCommand(ctx context.Context, cmd *proto.Command, result *proto.CommandResult) {
+	fields := strings.Fields(cmd.Content)
+	if len(fields) == 0 || len(fields) > 2 || fields[0] != "rebaseline" {
+		result.Success = false
+		result.Error = "命令格式应为 \"rebaseline [路径]\""
+		return
+	}
+
+	pattern := ""
+	if len(fields) == 2 {
+		pattern = fields[1]
+	}
+
+	accepted, err := ce.client.integrity.Rebaseline(ctx, pattern)
+	if err != nil {
+		result.Success = false
+		result.Error = err.Error()
+		return
+	}
+
+	// 立即上报，使服务端尽快看到新的基线
+	ce.client.registry.Invalidate(ce.client.integrity.Name())
+	ce.client.RequestReport()
+
+	result.Success = true
+	if pattern == "" {
+		result.Output = fmt.Sprintf("基线已重建，接受了 %d 处变化\n", accepted)
+	} else {
+		result.Output = fmt.Sprintf("已接受 %s 下的 %d 处变化\n", pattern, accepted)
+	}
+}
+
+// formatIntegrityReport 将文件完整性监控结果格式化为可读文本
+func formatIntegrityReport(report *proto.IntegrityReport) string {
+	if report == nil {
+		return "未配置文件完整性监控（integrity.paths）\n"
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("基线: %d 个文件，建立于 %s\n",
+		report.FileCount, time.Unix(report.BaselinedAt, 0).Format(time.RFC3339)))
+	sb.WriteString(fmt.Sprintf("与基线不一致的文件: %d\n", len(report.Changes)))
+	for _, change := range report.Changes {
+		sb.WriteString(fmt.Sprintf("  %s\n", models.FormatFileChange(change)))
+	}
+	return sb.String()
+}
@@ -0,0 +1,293 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"maps"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"time"
+
+	"GoMonitor/client/collectors"
+	"GoMonitor/pkg/config"
+	"GoMonitor/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+const (
+	// logBatchSize 是单次发送的最大日志行数
+	logBatchSize = 500
+	// logBatchBytes 是单次发送的日志编码后的最大字节数，远低于gRPC默认4MB的消息大小限制
+	logBatchBytes = 1 << 20
+	// logFlushInterval 是未攒满一批时发送已读取日志的最长等待时间
+	logFlushInterval = time.Second
+	// logBufferSize 是读取器和发送循环之间的缓冲行数，缓冲满时读取暂停
+	logBufferSize = 5000
+)
+
+// LogForwarder 将日志读取器读取到的行分批发送到服务端
+// 每批被服务端确认后才保存对应的读取位置，客户端重启后从最后确认的位置继续，可能重复但不会丢失
+type LogForwarder struct {
+	client  *Client
+	records chan collectors.LogRecord
+	reader  *collectors.LogReader
+
+	mu        sync.Mutex
+	cfg       config.LogConfig
+	started   bool
+	loaded    bool
+	read      map[string]collectors.LogPosition // 已读取到的位置，重启读取器时从这里继续
+	committed map[string]collectors.LogPosition // 服务端已确认的位置，保存到state_file
+}
+
+// NewLogForwarder 创建日志转发器并启动发送循环
+func NewLogForwarder(client *Client) *LogForwarder {
+	f := &LogForwarder{
+		client:    client,
+		records:   make(chan collectors.LogRecord, logBufferSize),
+		read:      make(map[string]collectors.LogPosition),
+		committed: make(map[string]collectors.LogPosition),
+	}
+	f.reader = collectors.NewLogReader(f.records)
+	go f.run()
+	return f
+}
+
+// Apply 按配置（重新）启动日志读取，配置未变化时不做任何事
+func (f *LogForwarder) Apply(cfg config.LogConfig) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.started && reflect.DeepEqual(f.cfg, cfg) {
+		return
+	}
+
+	// 停止读取器后读取位置不再变化，新的读取器从已读取的位置继续，缓冲中的行照常发送
+	f.reader.Stop()
+	f.started = false
+	f.cfg = cfg
+
+	if !cfg.Enabled() {
+		return
+	}
+
+	if !f.loaded {
+		positions, err := loadLogPositions(cfg.StateFile)
+		if err != nil {
+			log.Printf("读取日志转发位置失败，从文件末尾开始: %v", err)
+		}
+		maps.Copy(f.committed, positions)
+		maps.Copy(f.read, positions)
+		f.loaded = true
+	}
+
+	if err := f.reader.Start(logFileSpecs(cfg.Files), journalSpec(cfg.Journald), maps.Clone(f.read)); err != nil {
+		log.Printf("启动日志转发失败: %v", err)
+		return
+	}
+	f.started = true
+}
+
+// Stop 停止日志读取，尚未发送的行被丢弃，下次启动时从已确认的位置重新读取
+func (f *LogForwarder) Stop() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.reader.Stop()
+	f.started = false
+}
+
+// run 从缓冲中攒批并发送，直到程序退出
+func (f *LogForwarder) run() {
+	var batch []collectors.LogRecord
+	batchBytes := 0
+	timer := time.NewTimer(logFlushInterval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case record := <-f.records:
+			f.markRead(record)
+			batch = append(batch, record)
+			batchBytes += protobuf.Size(record.Line)
+			if len(batch) < logBatchSize && batchBytes < logBatchBytes {
+				continue
+			}
+		case <-timer.C:
+			timer.Reset(logFlushInterval)
+			if len(batch) == 0 {
+				continue
+			}
+		}
+
+		f.sendWithRetry(batch)
+		batch = batch[:0]
+		batchBytes = 0
+	}
+}
+
+// markRead 记录读取器已读取到的位置
+func (f *LogForwarder) markRead(record collectors.LogRecord) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.read[record.Key] = record.Position
+}
+
+// sendWithRetry 发送一批日志直到服务端确认，期间读取器因缓冲满而暂停
+func (f *LogForwarder) sendWithRetry(batch []collectors.LogRecord) {
+	var lines []*proto.LogLine
+	for _, record := range batch {
+		if record.Line != nil {
+			lines = append(lines, record.Line)
+		}
+	}
+
+	f.deliver(lines)
+	f.commit(batch)
+}
+
+// deliver 发送日志直到服务端确认，临时错误按指数退避重试
+// 服务端因消息过大或内容无效而拒绝时重试不会成功，拆成两半分别发送，单行仍被拒绝时丢弃
+func (f *LogForwarder) deliver(lines []*proto.LogLine) {
+	backoff := NewBackoff(time.Second, time.Minute)
+	for len(lines) > 0 {
+		err := f.send(lines)
+		if err == nil {
+			return
+		}
+
+		if isPermanentSendError(err) {
+			if len(lines) == 1 {
+				log.Printf("服务端拒绝日志行（来源 %s，%d 字节），已丢弃: %v", lines[0].Source, len(lines[0].Line), err)
+				return
+			}
+			half := len(lines) / 2
+			log.Printf("服务端拒绝 %d 行日志: %v，拆分后重新发送", len(lines), err)
+			f.deliver(lines[:half])
+			f.deliver(lines[half:])
+			return
+		}
+
+		wait := backoff.Next()
+		log.Printf("发送日志失败: %v，%v后重试", err, wait.Round(time.Millisecond))
+		time.Sleep(wait)
+	}
+}
+
+// isPermanentSendError 判断发送失败是否由日志本身引起，重发同样的内容不会成功
+func isPermanentSendError(err error) bool {
+	switch status.Code(err) {
+	case codes.ResourceExhausted, codes.InvalidArgument:
+		return true
+	}
+	return false
+}
+
+// send 发送一批日志，服务端不再识别客户端时重新注册后重发
+func (f *LogForwarder) send(lines []*proto.LogLine) error {
+	clientID := f.client.ClientID()
+	if clientID == "" {
+		return fmt.Errorf("客户端未注册")
+	}
+
+	err := f.sendBatch(clientID, lines)
+	if isUnknownClientError(err) {
+		f.client.reregister(clientID)
+		err = f.sendBatch(f.client.ClientID(), lines)
+	}
+	return err
+}
+
+// sendBatch 以指定的客户端ID发送一批日志
+func (f *LogForwarder) sendBatch(clientID string, lines []*proto.LogLine) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := f.client.client.SendLogs(ctx, &proto.LogBatch{
+		ClientId: clientID,
+		Lines:    lines,
+	})
+	if err != nil {
+		return err
+	}
+	if !resp.Received {
+		return fmt.Errorf("服务器拒绝接收日志: %s", resp.Message)
+	}
+	return nil
+}
+
+// commit 记录服务端已确认的位置并保存
+func (f *LogForwarder) commit(batch []collectors.LogRecord) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, record := range batch {
+		f.committed[record.Key] = record.Position
+	}
+	if f.cfg.StateFile == "" {
+		return
+	}
+	if err := saveLogPositions(f.cfg.StateFile, f.committed); err != nil {
+		log.Printf("保存日志转发位置失败: %v", err)
+	}
+}
+
+// loadLogPositions 读取保存的日志转发位置，文件不存在时返回空
+func loadLogPositions(path string) (map[string]collectors.LogPosition, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var positions map[string]collectors.LogPosition
+	if err := json.Unmarshal(data, &positions); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return positions, nil
+}
+
+// saveLogPositions 原子地写入日志转发位置
+func saveLogPositions(path string, positions map[string]collectors.LogPosition) error {
+	data, err := json.Marshal(positions)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// logFileSpecs 将配置转换为日志读取器使用的描述
+func logFileSpecs(files []config.LogFileConfig) []collectors.LogFileSpec {
+	specs := make([]collectors.LogFileSpec, len(files))
+	for i, file := range files {
+		specs[i] = collectors.LogFileSpec{
+			Path:    file.Path,
+			Include: file.Include,
+			Exclude: file.Exclude,
+		}
+	}
+	return specs
+}
+
+// journalSpec 将配置转换为journald读取使用的描述
+func journalSpec(cfg config.JournalConfig) collectors.JournalSpec {
+	return collectors.JournalSpec{
+		Enabled: cfg.Enabled,
+		Units:   cfg.Units,
+		Include: cfg.Include,
+		Exclude: cfg.Exclude,
+	}
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"GoMonitor/client/collectors"
+	"GoMonitor/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeLogService 记录收到的日志批次，可以按批次内容拒绝
+type fakeLogService struct {
+	proto.SystemInfoServiceClient
+
+	mu      sync.Mutex
+	reject  func(lines []*proto.LogLine) error
+	batches [][]string
+}
+
+func (s *fakeLogService) SendLogs(ctx context.Context, in *proto.LogBatch, opts ...grpc.CallOption) (*proto.LogBatchResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.reject != nil {
+		if err := s.reject(in.Lines); err != nil {
+			return nil, err
+		}
+	}
+	var batch []string
+	for _, line := range in.Lines {
+		batch = append(batch, line.Line)
+	}
+	s.batches = append(s.batches, batch)
+	return &proto.LogBatchResponse{Received: true}, nil
+}
+
+func (s *fakeLogService) received() [][]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([][]string(nil), s.batches...)
+}
+
+func TestLogForwarderSplitsRejectedBatches(t *testing.T) {
+	service := &fakeLogService{reject: func(lines []*proto.LogLine) error {
+		for _, line := range lines {
+			if line.Line == "bad" {
+				return status.Error(codes.InvalidArgument, "无效的日志行")
+			}
+		}
+		if len(lines) > 2 {
+			return status.Error(codes.ResourceExhausted, "消息过大")
+		}
+		return nil
+	}}
+	f := &LogForwarder{client: &Client{client: service, clientID: "c1"}}
+
+	var lines []*proto.LogLine
+	for _, text := range []string{"a", "b", "bad", "c", "d"} {
+		lines = append(lines, &proto.LogLine{Source: "/var/log/syslog", Line: text})
+	}
+
+	done := make(chan struct{})
+	go func() {
+		f.deliver(lines)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("被拒绝的批次一直在重试")
+	}
+
+	var got []string
+	for _, batch := range service.received() {
+		got = append(got, batch...)
+	}
+	if strings.Join(got, ",") != "a,b,c,d" {
+		t.Fatalf("服务端收到 %v，期望 a,b,c,d", got)
+	}
+}
+
+func TestLogForwarderLimitsBatchBytes(t *testing.T) {
+	service := &fakeLogService{}
+	f := NewLogForwarder(&Client{client: service, clientID: "c1"})
+
+	line := strings.Repeat("x", logBatchBytes/2+1)
+	for i := 0; i < 3; i++ {
+		f.records <- collectors.LogRecord{Key: "/var/log/syslog", Line: &proto.LogLine{Source: "/var/log/syslog", Line: line}}
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for len(service.received()) < 2 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	batches := service.received()
+	if len(batches) != 2 || len(batches[0]) != 2 || len(batches[1]) != 1 {
+		sizes := make([]int, len(batches))
+		for i, batch := range batches {
+			sizes[i] = len(batch)
+		}
+		t.Fatalf("各批次行数 = %v，期望 [2 1]", sizes)
+	}
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"GoMonitor/client/collectors"
+	"GoMonitor/proto"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+const (
+	// maxLogRuleLines 是每条规则上报的最近匹配行数
+	maxLogRuleLines = 10
+	// logRuleBufferSize 是每条规则的读取器和统计之间的缓冲行数
+	logRuleBufferSize = 100
+)
+
+// LogRuleEvaluator 在客户端评估服务端下发的日志告警规则
+// 每条规则单独读取其日志源，统计窗口内匹配的行数（按读取到的时间），上报时附带最近匹配的行
+// 与日志转发互不影响：规则读取的行不经过 logs 的 include/exclude 过滤，也不需要转发到服务端
+type LogRuleEvaluator struct {
+	mu    sync.Mutex
+	rules []*logRuleWatch
+}
+
+// logRuleWatch 是一条规则的读取器和窗口状态
+type logRuleWatch struct {
+	spec   *proto.LogRuleSpec
+	reader *collectors.LogReader
+	done   chan struct{}
+	err    error // 读取器启动失败的原因，此时reader为nil，随结果上报
+
+	mu    sync.Mutex
+	times []time.Time      // 窗口内匹配行的读取时间
+	lines []*proto.LogLine // 最近匹配的行
+}
+
+// NewLogRuleEvaluator 创建日志告警规则评估器
+func NewLogRuleEvaluator() *LogRuleEvaluator {
+	return &LogRuleEvaluator{}
+}
+
+// SetRules 应用服务端下发的规则，定义未变化的规则保留窗口状态，其余规则重新开始读取
+// 新规则从日志末尾开始读取，不统计历史日志；启动失败的规则保留并上报失败原因，下次下发时重试
+func (e *LogRuleEvaluator) SetRules(specs []*proto.LogRuleSpec) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	previous := make(map[string]*logRuleWatch, len(e.rules))
+	for _, watch := range e.rules {
+		previous[watch.spec.Name] = watch
+	}
+
+	rules := make([]*logRuleWatch, 0, len(specs))
+	for _, spec := range specs {
+		if watch, ok := previous[spec.Name]; ok && watch.err == nil && protobuf.Equal(watch.spec, spec) {
+			rules = append(rules, watch)
+			delete(previous, spec.Name)
+			continue
+		}
+
+		watch, err := startLogRuleWatch(spec)
+		if err != nil {
+			log.Printf("日志告警规则 %s 无效: %v", spec.Name, err)
+			watch = &logRuleWatch{spec: spec, err: err}
+		}
+		rules = append(rules, watch)
+	}
+
+	for _, watch := range previous {
+		watch.stop()
+	}
+	e.rules = rules
+}
+
+// Stop 停止所有规则的读取
+func (e *LogRuleEvaluator) Stop() {
+	e.SetRules(nil)
+}
+
+// Results 返回各规则当前窗口内的匹配情况
+func (e *LogRuleEvaluator) Results() []*proto.LogRuleResult {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	now := time.Now()
+	results := make([]*proto.LogRuleResult, 0, len(e.rules))
+	for _, watch := range e.rules {
+		results = append(results, watch.result(now))
+	}
+	return results
+}
+
+// startLogRuleWatch 按规则的日志源启动读取器
+func startLogRuleWatch(spec *proto.LogRuleSpec) (*logRuleWatch, error) {
+	var files []collectors.LogFileSpec
+	var journal collectors.JournalSpec
+	if spec.Source == collectors.JournalSource || strings.HasPrefix(spec.Source, collectors.JournalSource+":") {
+		journal = collectors.JournalSpec{Enabled: true, Include: []string{spec.Pattern}}
+		if unit, ok := strings.CutPrefix(spec.Source, collectors.JournalSource+":"); ok {
+			journal.Units = []string{unit}
+		}
+	} else {
+		files = []collectors.LogFileSpec{{Path: spec.Source, Include: []string{spec.Pattern}}}
+	}
+
+	records := make(chan collectors.LogRecord, logRuleBufferSize)
+	watch := &logRuleWatch{
+		spec:   spec,
+		reader: collectors.NewLogReader(records),
+		done:   make(chan struct{}),
+	}
+	if err := watch.reader.Start(files, journal, nil); err != nil {
+		return nil, err
+	}
+	go watch.run(records)
+	return watch, nil
+}
+
+// run 统计读取到的匹配行，直到规则被停止
+func (w *logRuleWatch) run(records <-chan collectors.LogRecord) {
+	for {
+		select {
+		case record := <-records:
+			// 未通过过滤的行只用于推进读取位置
+			if record.Line != nil {
+				w.add(record.Line, time.Now())
+			}
+		case <-w.done:
+			return
+		}
+	}
+}
+
+// add 记录一行匹配的日志
+func (w *logRuleWatch) add(line *proto.LogLine, now time.Time) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.expire(now)
+	w.times = append(w.times, now)
+	w.lines = append(w.lines, line)
+	if len(w.lines) > maxLogRuleLines {
+		w.lines = w.lines[len(w.lines)-maxLogRuleLines:]
+	}
+}
+
+// expire 淘汰窗口之外的匹配，调用方需持有w.mu
+func (w *logRuleWatch) expire(now time.Time) {
+	cutoff := now.Add(-time.Duration(w.spec.WindowSeconds) * time.Second)
+	expired := 0
+	for expired < len(w.times) && w.times[expired].Before(cutoff) {
+		expired++
+	}
+	w.times = w.times[expired:]
+	// 保留的行都是最近的匹配，超出窗口内匹配数的部分已过期
+	if len(w.lines) > len(w.times) {
+		w.lines = w.lines[len(w.lines)-len(w.times):]
+	}
+}
+
+// result 返回窗口内的匹配情况
+func (w *logRuleWatch) result(now time.Time) *proto.LogRuleResult {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.err != nil {
+		return &proto.LogRuleResult{Name: w.spec.Name, Error: w.err.Error()}
+	}
+
+	w.expire(now)
+	return &proto.LogRuleResult{
+		Name:  w.spec.Name,
+		Count: int32(len(w.times)),
+		Lines: append([]*proto.LogLine(nil), w.lines...),
+	}
+}
+
+// stop 停止读取
+func (w *logRuleWatch) stop() {
+	if w.reader == nil {
+		return
+	}
+	w.reader.Stop()
+	close(w.done)
+}
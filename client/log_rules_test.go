@@ -0,0 +1,84 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"GoMonitor/proto"
+)
+
+func TestLogRuleEvaluatorCountsMatches(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "kern.log")
+	if err := os.WriteFile(path, []byte("I/O error before start\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	e := NewLogRuleEvaluator()
+	defer e.Stop()
+	e.SetRules([]*proto.LogRuleSpec{{Name: "io", Pattern: "I/O error", Source: path, WindowSeconds: 60}})
+
+	// 等读取器记录文件末尾后再追加
+	time.Sleep(200 * time.Millisecond)
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.WriteString("sda: I/O error\nok\nsdb: I/O error\n")
+	f.Close()
+
+	deadline := time.Now().Add(5 * time.Second)
+	var result *proto.LogRuleResult
+	for time.Now().Before(deadline) {
+		result = e.Results()[0]
+		if result.Count >= 2 {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	if result.Count != 2 || len(result.Lines) != 2 || result.Lines[1].Line != "sdb: I/O error" {
+		t.Fatalf("结果 = %v", result)
+	}
+
+	// 定义未变化时保留窗口状态
+	e.SetRules([]*proto.LogRuleSpec{{Name: "io", Pattern: "I/O error", Source: path, WindowSeconds: 60}})
+	if got := e.Results()[0].Count; got != 2 {
+		t.Fatalf("重新应用相同规则后匹配数 = %d", got)
+	}
+
+	e.SetRules(nil)
+	if got := e.Results(); len(got) != 0 {
+		t.Fatalf("删除规则后仍有结果: %v", got)
+	}
+}
+
+func TestLogRuleWatchExpire(t *testing.T) {
+	w := &logRuleWatch{spec: &proto.LogRuleSpec{Name: "io", WindowSeconds: 60}}
+	now := time.Now()
+	for i := 0; i < 12; i++ {
+		w.add(&proto.LogLine{Line: "I/O error"}, now.Add(time.Duration(i-11)*10*time.Second))
+	}
+
+	result := w.result(now)
+	// 窗口为60秒，最近7次匹配（-60s至0s）在窗口内
+	if result.Count != 7 || len(result.Lines) != 7 {
+		t.Fatalf("匹配数 = %d, 行数 = %d", result.Count, len(result.Lines))
+	}
+	if result := w.result(now.Add(2 * time.Minute)); result.Count != 0 || len(result.Lines) != 0 {
+		t.Fatalf("窗口过后仍有匹配: %v", result)
+	}
+}
+
+func TestLogRuleEvaluatorReportsFailedRules(t *testing.T) {
+	e := NewLogRuleEvaluator()
+	defer e.Stop()
+
+	spec := &proto.LogRuleSpec{Name: "bad", Pattern: "(", Source: "journald", WindowSeconds: 60}
+	e.SetRules([]*proto.LogRuleSpec{spec})
+
+	results := e.Results()
+	if len(results) != 1 || results[0].Name != "bad" || results[0].Error == "" {
+		t.Fatalf("结果 = %v，期望包含失败原因", results)
+	}
+}
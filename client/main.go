@@ -3,49 +3,90 @@ package main
 import (
 	"flag"
 	"log"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
+
+	"GoMonitor/pkg/config"
 )
 
 var (
-	serverAddr = flag.String("server", "localhost:50051", "服务器地址")
-	interval   = flag.Int("interval", 60, "收集系统信息的间隔（秒）")
+	configPath = flag.String("config", "", "配置文件路径（YAML）")
+	serverAddr = flag.String("server", "localhost:50025", "服务器地址，设置后覆盖配置文件")
+	interval   = flag.Int("interval", 60, "收集系统信息的间隔（秒），设置后覆盖配置文件")
 )
 
+// loadConfig 加载配置文件和环境变量，并应用命令行中显式指定的参数
+func loadConfig() (*config.ClientConfig, error) {
+	cfg, err := config.LoadClientConfig(*configPath)
+	if err != nil {
+		return nil, err
+	}
+
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "server":
+			cfg.Server = *serverAddr
+		case "interval":
+			cfg.Interval = time.Duration(*interval) * time.Second
+		}
+	})
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
 func main() {
 	flag.Parse()
 
-	log.Printf("客户端启动，服务器地址: %s", *serverAddr)
+	cfg, err := loadConfig()
+	if err != nil {
+		log.Fatalf("加载配置失败: %v", err)
+	}
+
+	log.Printf("客户端启动，服务器地址: %s", cfg.Server)
 
-	c, err := NewClient(*serverAddr)
+	c, err := NewClient(cfg)
 	if err != nil {
 		log.Fatalf("创建客户端失败: %v", err)
 	}
 	defer c.Close()
 
-	for {
-		err = c.Register()
-		if err == nil {
-			break
-		}
-
-		log.Printf("注册失败: %v，5秒后重试", err)
-		time.Sleep(5 * time.Second)
-	}
+	c.RegisterWithRetry()
 
 	c.StartReceivingCommands()
 
-	ticker := time.NewTicker(time.Duration(*interval) * time.Second)
+	reload := make(chan os.Signal, 1)
+	signal.Notify(reload, syscall.SIGHUP)
+
+	ticker := time.NewTicker(c.Interval())
 	defer ticker.Stop()
 
+	reporter := NewReporter(c.SendSystemInfo)
+
 	for {
 		select {
 		case <-ticker.C:
-			err := c.SendSystemInfo()
+			reporter.Trigger()
+
+		case <-reload:
+			newCfg, err := loadConfig()
 			if err != nil {
-				log.Printf("发送系统信息失败: %v", err)
-			} else {
-				log.Printf("系统信息发送成功")
+				log.Printf("重新加载配置失败，继续使用原配置: %v", err)
+				continue
 			}
+
+			c.ApplyConfig(newCfg)
+			log.Printf("配置已重新加载")
+
+		case <-c.IntervalChanged():
+			ticker.Reset(c.Interval())
+
+		case <-c.ReportRequested():
+			reporter.Trigger()
 		}
 	}
 }
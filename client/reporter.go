@@ -0,0 +1,54 @@
+package main
+
+import (
+	"log"
+	"sync"
+)
+
+// Reporter 在后台上报系统信息，使主循环不会因上报（以及其中可能发生的重新注册）而阻塞
+// 上报进行中再次触发时不会并发上报，而是在本次结束后合并为一次补报
+type Reporter struct {
+	send func() error
+
+	mu      sync.Mutex
+	running bool
+	pending bool
+}
+
+// NewReporter 创建后台上报器，send执行一次上报
+func NewReporter(send func() error) *Reporter {
+	return &Reporter{send: send}
+}
+
+// Trigger 触发一次上报，立即返回
+func (r *Reporter) Trigger() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.running {
+		r.pending = true
+		return
+	}
+	r.running = true
+	go r.run()
+}
+
+// run 执行上报，期间有新的触发时继续上报一次
+func (r *Reporter) run() {
+	for {
+		if err := r.send(); err != nil {
+			log.Printf("发送系统信息失败: %v", err)
+		} else {
+			log.Printf("系统信息发送成功")
+		}
+
+		r.mu.Lock()
+		if !r.pending {
+			r.running = false
+			r.mu.Unlock()
+			return
+		}
+		r.pending = false
+		r.mu.Unlock()
+	}
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestReporterDoesNotBlock(t *testing.T) {
+	release := make(chan struct{})
+	var calls atomic.Int32
+	r := NewReporter(func() error {
+		calls.Add(1)
+		<-release
+		return nil
+	})
+
+	// 上报阻塞（例如在等待重新注册）时触发立即返回，并合并为一次补报
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < 5; i++ {
+			r.Trigger()
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("上报阻塞了触发")
+	}
+
+	close(release)
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		r.mu.Lock()
+		running := r.running
+		r.mu.Unlock()
+		if !running {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Fatalf("上报 %d 次，期望 2 次", got)
+	}
+}
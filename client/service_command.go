@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"GoMonitor/client/collectors"
+	"GoMonitor/proto"
+)
+
+// LSB约定的服务状态退出码，与 systemctl status 一致
+const (
+	serviceStatusRunning  = 0
+	serviceStatusStopped  = 3
+	serviceStatusNotFound = 4
+)
+
+// ExecuteServiceCommand 执行service命令，内容格式为 "<操作> <单元>"，例如 "restart nginx.service"
+// start、stop、restart、reload的退出码为systemctl的退出码；status按LSB约定返回，单元运行中时才视为成功
+func (ce *CommandExecutor) ExecuteServiceCommand(ctx context.Context, cmd *proto.Command, result *proto.CommandResult) {
+	fields := strings.Fields(cmd.Content)
+	if len(fields) != 2 {
+		result.Success = false
+		result.Error = fmt.Sprintf("命令格式应为 \"<操作> <单元>\"，操作可选: %s", strings.Join(collectors.ServiceActions, ", "))
+		return
+	}
+	action, unit := fields[0], fields[1]
+
+	if action != "status" {
+		timeout := time.Duration(cmd.TimeoutSeconds) * time.Second
+		exitCode, output, err := collectors.ControlUnit(ctx, action, unit, timeout)
+		if err != nil {
+			result.Success = false
+			result.Error = err.Error()
+			return
+		}
+
+		result.ExitCode = int32(exitCode)
+		result.Success = exitCode == 0
+		if !result.Success {
+			result.Error = fmt.Sprintf("systemctl %s %s 失败，退出码 %d: %s", action, unit, exitCode, output)
+			return
+		}
+	}
+
+	units, err := collectors.QueryUnits(ctx, []string{unit})
+	if err != nil {
+		result.Success = false
+		result.Error = err.Error()
+		return
+	}
+
+	state := units[0]
+	result.Output = formatServiceUnit(state)
+
+	if action == "status" {
+		switch {
+		case state.LoadState == "not-found":
+			result.ExitCode = serviceStatusNotFound
+		case state.ActiveState == "active":
+			result.ExitCode = serviceStatusRunning
+		default:
+			result.ExitCode = serviceStatusStopped
+		}
+		result.Success = result.ExitCode == serviceStatusRunning
+		if !result.Success {
+			result.Error = fmt.Sprintf("单元 %s 未运行: %s/%s", unit, state.LoadState, state.ActiveState)
+		}
+	}
+}
+
+// formatServiceUnit 将单元状态格式化为可读文本
+func formatServiceUnit(unit *proto.ServiceUnit) string {
+	return fmt.Sprintf("%s (%s): %s/%s, 加载状态: %s, 开机启动: %s, 主进程: %d, 重启次数: %d\n",
+		unit.Id, unit.Description, unit.ActiveState, unit.SubState,
+		unit.LoadState, unit.UnitFileState, unit.MainPid, unit.Restarts)
+}
@@ -10,6 +10,7 @@ require (
 	github.com/shirou/gopsutil v3.21.11+incompatible
 	google.golang.org/grpc v1.71.0
 	google.golang.org/protobuf v1.36.4
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -0,0 +1,39 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"GoMonitor/pkg/models"
+)
+
+// AdvisoryNone 表示不因公告命中触发告警
+const AdvisoryNone = "none"
+
+// AdvisoryConfig 离线安全公告配置
+// 公告在启动和收到SIGHUP时重新读取，更新公告文件后发送SIGHUP即可生效
+type AdvisoryConfig struct {
+	Paths            []string `yaml:"paths"`              // OSV格式的公告文件或目录，支持 .json 和 OSV 导出的 .zip
+	AlertMinSeverity string   `yaml:"alert_min_severity"` // 命中的公告达到该级别时触发告警，none表示不触发
+}
+
+// AlertsFor 判断该严重级别的公告命中时是否触发告警
+func (c AdvisoryConfig) AlertsFor(severity string) bool {
+	if c.AlertMinSeverity == AdvisoryNone {
+		return false
+	}
+	return models.SeverityRank(severity) >= models.SeverityRank(c.AlertMinSeverity)
+}
+
+func (c AdvisoryConfig) validate(ve *validationErrors) {
+	for i, path := range c.Paths {
+		if _, err := os.Stat(path); err != nil {
+			ve.add(fmt.Sprintf("advisories.paths[%d]", i), "%v", err)
+		}
+	}
+
+	if c.AlertMinSeverity != AdvisoryNone && !contains(models.AdvisorySeverities, c.AlertMinSeverity) {
+		ve.add("advisories.alert_min_severity", "可选值: %s, %s", strings.Join(models.AdvisorySeverities, ", "), AdvisoryNone)
+	}
+}
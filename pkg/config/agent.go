@@ -0,0 +1,92 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// AgentConfigOverlay 是一层下发给客户端的配置，未设置的字段（零值或nil）沿用下层的值
+type AgentConfigOverlay struct {
+	Interval            time.Duration `yaml:"interval"`
+	Collectors          []string      `yaml:"collectors"`
+	InterfaceExclude    []string      `yaml:"interface_exclude"`
+	PartitionExclude    []string      `yaml:"partition_exclude"`
+	AllowedCommandTypes []string      `yaml:"allowed_command_types"`
+}
+
+// AgentConfigSet 服务端维护的分层客户端配置
+// 生效顺序：default < groups（按客户端标签 group 匹配） < clients（按主机名匹配）
+type AgentConfigSet struct {
+	Default AgentConfigOverlay            `yaml:"default"`
+	Groups  map[string]AgentConfigOverlay `yaml:"groups"`
+	Clients map[string]AgentConfigOverlay `yaml:"clients"`
+}
+
+// GroupLabel 是决定客户端所属分组的标签名
+const GroupLabel = "group"
+
+// Merge 以top覆盖o中对应的已设置字段，返回合并后的结果
+func (o AgentConfigOverlay) Merge(top AgentConfigOverlay) AgentConfigOverlay {
+	if top.Interval > 0 {
+		o.Interval = top.Interval
+	}
+	if top.Collectors != nil {
+		o.Collectors = top.Collectors
+	}
+	if top.InterfaceExclude != nil {
+		o.InterfaceExclude = top.InterfaceExclude
+	}
+	if top.PartitionExclude != nil {
+		o.PartitionExclude = top.PartitionExclude
+	}
+	if top.AllowedCommandTypes != nil {
+		o.AllowedCommandTypes = top.AllowedCommandTypes
+	}
+	return o
+}
+
+// Resolve 计算指定主机名和分组的客户端最终生效的配置
+func (s AgentConfigSet) Resolve(hostname string, group string) AgentConfigOverlay {
+	result := s.Default
+	if group != "" {
+		if overlay, ok := s.Groups[group]; ok {
+			result = result.Merge(overlay)
+		}
+	}
+	if overlay, ok := s.Clients[hostname]; ok {
+		result = result.Merge(overlay)
+	}
+	return result
+}
+
+// Validate 校验单层配置
+func (o AgentConfigOverlay) Validate(field string) error {
+	var ve validationErrors
+	o.validate(field, &ve)
+	return ve.err()
+}
+
+func (o AgentConfigOverlay) validate(field string, ve *validationErrors) {
+	if o.Interval != 0 && o.Interval < time.Second {
+		ve.add(field+".interval", "不能小于1秒，当前为 %v", o.Interval)
+	}
+
+	for _, name := range o.Collectors {
+		if !contains(KnownCollectors, name) {
+			ve.add(field+".collectors", "未知的采集器 %q，可选值: %s", name, strings.Join(KnownCollectors, ", "))
+		}
+	}
+
+	CommandPolicy{AllowedCommandTypes: o.AllowedCommandTypes}.validate(field+".allowed_command_types", ve)
+}
+
+func (s AgentConfigSet) validate(ve *validationErrors) {
+	s.Default.validate("agent_config.default", ve)
+	for name, overlay := range s.Groups {
+		overlay.validate(fmt.Sprintf("agent_config.groups.%s", name), ve)
+	}
+	for name, overlay := range s.Clients {
+		overlay.validate(fmt.Sprintf("agent_config.clients.%s", name), ve)
+	}
+}
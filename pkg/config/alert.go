@@ -0,0 +1,156 @@
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"GoMonitor/pkg/models"
+	"GoMonitor/pkg/utils"
+)
+
+// KnownAlertOperators 是告警规则支持的比较运算符
+var KnownAlertOperators = []string{">", ">=", "<", "<="}
+
+// KnownSeverities 是告警规则可用的严重级别
+var KnownSeverities = []string{models.SeverityInfo, models.SeverityWarning, models.SeverityCritical}
+
+// 日志告警规则的评估位置
+const (
+	LogRuleEvaluateServer = "server" // 服务端评估客户端转发的日志
+	LogRuleEvaluateClient = "client" // 规则下发到客户端，由客户端读取日志并上报匹配数
+)
+
+// KnownLogRuleEvaluations 是日志告警规则可用的评估位置
+var KnownLogRuleEvaluations = []string{LogRuleEvaluateServer, LogRuleEvaluateClient}
+
+// AlertRule 指标阈值告警规则
+// 指标持续满足条件达到For指定的时间后触发告警，不再满足时自动恢复
+type AlertRule struct {
+	Name      string        `yaml:"name"`
+	Metric    string        `yaml:"metric"` // 指标名称，例如 memory.available_percent、psi.io.full_avg60、custom.nginx.active
+	Operator  string        `yaml:"operator"`
+	Threshold float64       `yaml:"threshold"`
+	For       time.Duration `yaml:"for"` // 持续时间，0表示立即触发
+	Severity  string        `yaml:"severity"`
+}
+
+// LogRule 日志告警规则，由服务端对客户端转发的日志进行评估，或下发到客户端由客户端直接读取日志评估
+// Window内匹配的日志行数达到Threshold时触发告警，告警附带最近匹配的行；匹配数低于阈值后自动恢复
+type LogRule struct {
+	Name    string `yaml:"name"`
+	Pattern string `yaml:"pattern"` // 正则表达式，匹配日志内容
+	// Source 服务端评估时只匹配该日志源，通配符或 "re:" 开头的正则表达式，为空时匹配所有日志源
+	// 客户端评估时为客户端读取的日志源：绝对路径（支持通配符）、"journald" 或 "journald:<单元>"，不能为空
+	Source    string        `yaml:"source"`
+	Threshold int           `yaml:"threshold"` // 窗口内的匹配行数
+	Window    time.Duration `yaml:"window"`
+	Severity  string        `yaml:"severity"`
+	Evaluate  string        `yaml:"evaluate"` // server（默认）或 client
+}
+
+// EvaluatedOnClient 判断规则是否由客户端评估
+func (r LogRule) EvaluatedOnClient() bool {
+	return r.Evaluate == LogRuleEvaluateClient
+}
+
+// Matches 判断指标值是否满足告警条件
+func (r AlertRule) Matches(value float64) bool {
+	switch r.Operator {
+	case ">":
+		return value > r.Threshold
+	case ">=":
+		return value >= r.Threshold
+	case "<":
+		return value < r.Threshold
+	case "<=":
+		return value <= r.Threshold
+	}
+	return false
+}
+
+func validateAlertRules(rules []AlertRule, ve *validationErrors) {
+	names := make(map[string]bool)
+	for i, rule := range rules {
+		field := fmt.Sprintf("alert_rules[%d]", i)
+
+		if rule.Name == "" {
+			ve.add(field+".name", "不能为空")
+		} else if names[rule.Name] {
+			ve.add(field+".name", "名称 %q 重复", rule.Name)
+		}
+		names[rule.Name] = true
+
+		if !models.IsKnownMetric(rule.Metric) {
+			ve.add(field+".metric", "未知的指标 %q，可选值: %s 或 %s<自定义指标名>",
+				rule.Metric, strings.Join(models.KnownMetrics(), ", "), models.CustomMetricPrefix)
+		}
+		if !contains(KnownAlertOperators, rule.Operator) {
+			ve.add(field+".operator", "未知的运算符 %q，可选值: %s", rule.Operator, strings.Join(KnownAlertOperators, " "))
+		}
+		if rule.For < 0 {
+			ve.add(field+".for", "不能为负数")
+		}
+		if !contains(KnownSeverities, rule.Severity) {
+			ve.add(field+".severity", "未知的级别 %q，可选值: %s", rule.Severity, strings.Join(KnownSeverities, ", "))
+		}
+	}
+}
+
+func validateLogRules(rules []LogRule, ve *validationErrors) {
+	names := make(map[string]bool)
+	for i, rule := range rules {
+		field := fmt.Sprintf("log_rules[%d]", i)
+
+		if rule.Name == "" {
+			ve.add(field+".name", "不能为空")
+		} else if names[rule.Name] {
+			ve.add(field+".name", "名称 %q 重复", rule.Name)
+		}
+		names[rule.Name] = true
+
+		if rule.Pattern == "" {
+			ve.add(field+".pattern", "不能为空")
+		} else if _, err := regexp.Compile(rule.Pattern); err != nil {
+			ve.add(field+".pattern", "无效的正则表达式 %q: %v", rule.Pattern, err)
+		}
+		switch rule.Evaluate {
+		case "", LogRuleEvaluateServer:
+			if rule.Source != "" {
+				if err := utils.ValidatePattern(rule.Source); err != nil {
+					ve.add(field+".source", "%v", err)
+				}
+			}
+		case LogRuleEvaluateClient:
+			validateClientLogSource(field+".source", rule.Source, ve)
+		default:
+			ve.add(field+".evaluate", "未知的评估位置 %q，可选值: %s", rule.Evaluate, strings.Join(KnownLogRuleEvaluations, ", "))
+		}
+		if rule.Threshold <= 0 {
+			ve.add(field+".threshold", "必须大于0")
+		}
+		if rule.Window <= 0 {
+			ve.add(field+".window", "必须大于0")
+		}
+		if !contains(KnownSeverities, rule.Severity) {
+			ve.add(field+".severity", "未知的级别 %q，可选值: %s", rule.Severity, strings.Join(KnownSeverities, ", "))
+		}
+	}
+}
+
+// validateClientLogSource 检查由客户端评估的规则的日志源
+func validateClientLogSource(field string, source string, ve *validationErrors) {
+	switch {
+	case source == "":
+		ve.add(field, "客户端评估时不能为空")
+	case source == "journald" || strings.HasPrefix(source, "journald:"):
+	case !filepath.IsAbs(source):
+		ve.add(field, "%q 必须为绝对路径、journald 或 journald:<单元>", source)
+	default:
+		if _, err := filepath.Match(source, ""); err != nil {
+			ve.add(field, "%q: %v", source, err)
+		}
+	}
+}
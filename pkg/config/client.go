@@ -0,0 +1,323 @@
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"GoMonitor/pkg/utils"
+)
+
+// KnownCollectors 是客户端支持的采集器名称
+var KnownCollectors = []string{"cpu", "memory", "disk", "network", "pressure", "cgroups", "systemd", "connections", "packages", "integrity", "logins", "accounts", "cron", "plugins", "checks"}
+
+// KnownPluginFormats 是外部指标插件支持的输出格式
+var KnownPluginFormats = []string{"keyvalue", "json", "prometheus"}
+
+// ClientConfig 客户端配置
+type ClientConfig struct {
+	Server     string            `yaml:"server"`
+	TLS        ClientTLSConfig   `yaml:"tls"`
+	Interval   time.Duration     `yaml:"interval"`
+	Collectors []string          `yaml:"collectors"`
+	Labels     map[string]string `yaml:"labels"`
+	Policies   CommandPolicy     `yaml:"policies"`
+
+	CollectorSettings map[string]CollectorSettings `yaml:"collector_settings"` // 按采集器名称配置
+	Plugins           []PluginConfig               `yaml:"plugins"`            // 外部指标插件
+	Checks            []CheckConfig                `yaml:"checks"`             // Nagios兼容检查
+	Cgroups           CgroupConfig                 `yaml:"cgroups"`            // 容器和cgroup资源采集
+	Systemd           SystemdConfig                `yaml:"systemd"`            // systemd单元状态采集
+	Filters           FilterConfig                 `yaml:"filters"`            // 网络接口和磁盘分区过滤规则
+	Packages          PackageConfig                `yaml:"packages"`           // 已安装软件包清单
+	Integrity         IntegrityConfig              `yaml:"integrity"`          // 文件完整性监控
+	Logs              LogConfig                    `yaml:"logs"`               // 日志转发
+	Logins            LoginConfig                  `yaml:"logins"`             // 登录会话和认证审计
+}
+
+// IntegrityConfig 文件完整性监控配置
+type IntegrityConfig struct {
+	Paths        []string `yaml:"paths"`         // 监控的文件或目录（绝对路径，支持通配符），目录会被递归监控
+	Exclude      []string `yaml:"exclude"`       // 排除的路径，通配符或 "re:" 开头的正则表达式
+	BaselineFile string   `yaml:"baseline_file"` // 基线保存位置，客户端重启后沿用
+	Inotify      bool     `yaml:"inotify"`       // 是否通过inotify在文件变化后立即重新扫描并上报（仅Linux）
+}
+
+func (c IntegrityConfig) validate(ve *validationErrors) {
+	for _, path := range c.Paths {
+		if !filepath.IsAbs(path) {
+			ve.add("integrity.paths", "%q 必须为绝对路径", path)
+		} else if _, err := filepath.Match(path, ""); err != nil {
+			ve.add("integrity.paths", "%q: %v", path, err)
+		}
+	}
+	for _, pattern := range c.Exclude {
+		if err := utils.ValidatePattern(pattern); err != nil {
+			ve.add("integrity.exclude", "%v", err)
+		}
+	}
+	if len(c.Paths) > 0 && c.BaselineFile == "" {
+		ve.add("integrity.baseline_file", "配置了 paths 时不能为空")
+	}
+}
+
+// LoginConfig 登录审计配置，登录会话和历史读取自utmp和wtmp，SSH认证结果读取自认证日志
+type LoginConfig struct {
+	AuthLogs []string      `yaml:"auth_logs"` // sshd写入的认证日志，不存在的文件被忽略；都不存在时从btmp统计失败登录
+	Window   time.Duration `yaml:"window"`    // 统计成功的SSH登录和失败登录的时间窗口
+	History  int           `yaml:"history"`   // 上报的最近登录记录数
+}
+
+func (c LoginConfig) validate(ve *validationErrors) {
+	for _, path := range c.AuthLogs {
+		if !filepath.IsAbs(path) {
+			ve.add("logins.auth_logs", "%q 必须为绝对路径", path)
+		}
+	}
+	if c.Window <= 0 {
+		ve.add("logins.window", "必须大于0")
+	}
+	if c.History < 0 {
+		ve.add("logins.history", "不能为负数")
+	}
+}
+
+// PackageConfig 软件包采集器配置，dpkg和rpm数据库存在时总是读取
+type PackageConfig struct {
+	Pip bool `yaml:"pip"` // 是否列出python3环境中的pip包
+	Npm bool `yaml:"npm"` // 是否列出npm全局包
+}
+
+// FilterConfig 网络接口和磁盘分区的过滤规则
+type FilterConfig struct {
+	Interfaces InterfaceFilter `yaml:"interfaces"`
+	Partitions PartitionFilter `yaml:"partitions"`
+}
+
+// InterfaceFilter 网络接口过滤规则
+type InterfaceFilter struct {
+	Name MatchRules `yaml:"name"`
+}
+
+// PartitionFilter 磁盘分区过滤规则，分区需要同时通过所有规则才会上报
+type PartitionFilter struct {
+	MountPoint MatchRules `yaml:"mount_point"`
+	Fstype     MatchRules `yaml:"fstype"`
+	Device     MatchRules `yaml:"device"`
+}
+
+// MatchRules 包含和排除规则，模式为通配符，以 "re:" 开头时为正则表达式
+// 包含规则为空时包含所有名称，同时满足两类规则时以排除为准
+type MatchRules struct {
+	Include []string `yaml:"include"`
+	Exclude []string `yaml:"exclude"`
+}
+
+func (r MatchRules) validate(field string, ve *validationErrors) {
+	for _, pattern := range r.Include {
+		if err := utils.ValidatePattern(pattern); err != nil {
+			ve.add(field+".include", "%v", err)
+		}
+	}
+	for _, pattern := range r.Exclude {
+		if err := utils.ValidatePattern(pattern); err != nil {
+			ve.add(field+".exclude", "%v", err)
+		}
+	}
+}
+
+// SystemdConfig systemd单元采集器配置
+type SystemdConfig struct {
+	Units         []string `yaml:"units"`          // 需要上报状态的单元，例如 nginx.service
+	IncludeFailed bool     `yaml:"include_failed"` // 是否额外上报所有处于failed状态的单元
+}
+
+// CgroupConfig cgroup采集器配置
+type CgroupConfig struct {
+	Root         string `yaml:"root"`          // cgroup文件系统挂载点
+	DockerSocket string `yaml:"docker_socket"` // Docker Engine API的unix socket，为空时不查询容器名称
+}
+
+// CheckConfig Nagios兼容检查插件配置
+type CheckConfig struct {
+	Name     string        `yaml:"name"`
+	Command  string        `yaml:"command"`
+	Args     []string      `yaml:"args"`
+	Interval time.Duration `yaml:"interval"`
+	Timeout  time.Duration `yaml:"timeout"`
+}
+
+// PluginConfig 外部指标插件配置
+type PluginConfig struct {
+	Name     string        `yaml:"name"`
+	Command  string        `yaml:"command"`
+	Args     []string      `yaml:"args"`
+	Interval time.Duration `yaml:"interval"`
+	Timeout  time.Duration `yaml:"timeout"`
+	Format   string        `yaml:"format"`
+}
+
+// CollectorSettings 单个采集器的采集间隔和超时
+type CollectorSettings struct {
+	Interval time.Duration `yaml:"interval"` // 两次采集的最小间隔，0表示每次上报都采集
+	Timeout  time.Duration `yaml:"timeout"`  // 单次采集超时，0表示使用默认值
+}
+
+// DefaultClientConfig 返回客户端默认配置
+func DefaultClientConfig() *ClientConfig {
+	return &ClientConfig{
+		Server:     "localhost:50025",
+		Interval:   60 * time.Second,
+		Collectors: append([]string{}, KnownCollectors...),
+		Labels:     map[string]string{},
+		CollectorSettings: map[string]CollectorSettings{
+			// 软件包很少变化，且rpm、pip等命令较慢，没有必要每次上报都读取
+			"packages": {Interval: 10 * time.Minute},
+			// 每次扫描都要计算所有监控文件的哈希；需要及时发现变化时可开启 integrity.inotify
+			"integrity": {Interval: 5 * time.Minute},
+			// 账户、sudo和计划任务配置很少变化
+			"accounts": {Interval: 5 * time.Minute},
+			"cron":     {Interval: 5 * time.Minute},
+		},
+		Cgroups: CgroupConfig{
+			Root:         "/sys/fs/cgroup",
+			DockerSocket: "/var/run/docker.sock",
+		},
+		Systemd: SystemdConfig{
+			IncludeFailed: true,
+		},
+		Integrity: IntegrityConfig{
+			BaselineFile: "/var/lib/gomonitor/integrity.json",
+		},
+		Logs: LogConfig{
+			StateFile: "/var/lib/gomonitor/log_offsets.json",
+		},
+		Logins: LoginConfig{
+			AuthLogs: []string{"/var/log/auth.log", "/var/log/secure"},
+			Window:   24 * time.Hour,
+			History:  20,
+		},
+		Filters: FilterConfig{
+			Interfaces: InterfaceFilter{
+				Name: MatchRules{Exclude: []string{"lo*", "veth*", "docker*", "br-*", "vmnet*", "vbox*", "virbr*"}},
+			},
+			Partitions: PartitionFilter{
+				Fstype: MatchRules{Exclude: []string{"tmpfs", "devtmpfs", "overlay", "squashfs", "nsfs", "autofs"}},
+			},
+		},
+		Policies: CommandPolicy{
+			AllowedCommandTypes: append([]string{}, KnownCommandTypes...),
+		},
+	}
+}
+
+// LoadClientConfig 加载客户端配置：默认值 < 配置文件 < 环境变量(GOMONITOR_CLIENT_*)
+func LoadClientConfig(path string) (*ClientConfig, error) {
+	cfg := DefaultClientConfig()
+
+	if err := loadFile(path, cfg); err != nil {
+		return nil, err
+	}
+
+	if err := applyEnv(EnvPrefix+"CLIENT", cfg); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// Validate 校验客户端配置
+func (c *ClientConfig) Validate() error {
+	var ve validationErrors
+
+	validateHostPort("server", c.Server, &ve)
+
+	c.TLS.validate(&ve)
+
+	if c.Interval < time.Second {
+		ve.add("interval", "不能小于1秒，当前为 %v", c.Interval)
+	}
+
+	for _, name := range c.Collectors {
+		if !contains(KnownCollectors, name) {
+			ve.add("collectors", "未知的采集器 %q，可选值: %s", name, strings.Join(KnownCollectors, ", "))
+		}
+	}
+
+	c.Policies.validate("policies.allowed_command_types", &ve)
+
+	for name, settings := range c.CollectorSettings {
+		field := "collector_settings." + name
+		if !contains(KnownCollectors, name) {
+			ve.add(field, "未知的采集器，可选值: %s", strings.Join(KnownCollectors, ", "))
+		}
+		if settings.Interval < 0 {
+			ve.add(field+".interval", "不能为负数")
+		}
+		if settings.Timeout < 0 {
+			ve.add(field+".timeout", "不能为负数")
+		}
+	}
+
+	if c.Cgroups.Root == "" {
+		ve.add("cgroups.root", "不能为空")
+	}
+
+	for _, unit := range c.Systemd.Units {
+		if unit == "" || strings.HasPrefix(unit, "-") || strings.ContainsAny(unit, " \t/") {
+			ve.add("systemd.units", "无效的单元名称 %q", unit)
+		}
+	}
+
+	c.Filters.Interfaces.Name.validate("filters.interfaces.name", &ve)
+	c.Filters.Partitions.MountPoint.validate("filters.partitions.mount_point", &ve)
+	c.Filters.Partitions.Fstype.validate("filters.partitions.fstype", &ve)
+	c.Filters.Partitions.Device.validate("filters.partitions.device", &ve)
+
+	c.Integrity.validate(&ve)
+	c.Logs.validate(&ve)
+	c.Logins.validate(&ve)
+
+	names := make(map[string]bool)
+	for i, plugin := range c.Plugins {
+		field := fmt.Sprintf("plugins[%d]", i)
+		validateExternal(field, plugin.Name, plugin.Command, plugin.Interval, plugin.Timeout, names, &ve)
+		if !contains(KnownPluginFormats, plugin.Format) {
+			ve.add(field+".format", "未知的格式 %q，可选值: %s", plugin.Format, strings.Join(KnownPluginFormats, ", "))
+		}
+	}
+
+	names = make(map[string]bool)
+	for i, check := range c.Checks {
+		field := fmt.Sprintf("checks[%d]", i)
+		validateExternal(field, check.Name, check.Command, check.Interval, check.Timeout, names, &ve)
+	}
+
+	return ve.err()
+}
+
+// validateExternal 校验外部程序类配置（插件、检查）的公共字段，names用于检测重名
+func validateExternal(field, name, command string, interval, timeout time.Duration, names map[string]bool, ve *validationErrors) {
+	if name == "" {
+		ve.add(field+".name", "不能为空")
+	} else if names[name] {
+		ve.add(field+".name", "名称 %q 重复", name)
+	}
+	names[name] = true
+
+	if command == "" {
+		ve.add(field+".command", "不能为空")
+	}
+	if interval < time.Second {
+		ve.add(field+".interval", "不能小于1秒，当前为 %v", interval)
+	}
+	if timeout <= 0 || (interval > 0 && timeout > interval) {
+		ve.add(field+".timeout", "必须大于0且不超过 interval，当前为 %v", timeout)
+	}
+}
+
+// CollectorEnabled 判断采集器是否启用
+func (c *ClientConfig) CollectorEnabled(name string) bool {
+	return contains(c.Collectors, name)
+}
@@ -0,0 +1,208 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// EnvPrefix 是所有环境变量覆盖项的公共前缀
+const EnvPrefix = "GOMONITOR_"
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// loadFile 读取YAML配置文件并解析到out中，未知字段视为错误以便尽早发现拼写问题
+// path为空时不读取文件，仅保留out中的默认值
+func loadFile(path string, out interface{}) error {
+	if path == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("读取配置文件失败: %w", err)
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	// 空文件会返回io.EOF，视为全部使用默认值
+	if err := decoder.Decode(out); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("解析配置文件 %s 失败: %w", path, err)
+	}
+
+	return nil
+}
+
+// applyEnv 使用环境变量覆盖配置项
+// 变量名由前缀加上各级yaml字段名的大写形式组成，例如 GOMONITOR_SERVER_TLS_CERT_FILE
+// 列表使用逗号分隔，映射使用 "k1=v1,k2=v2" 形式
+func applyEnv(prefix string, out interface{}) error {
+	return applyEnvValue(prefix, reflect.ValueOf(out).Elem())
+}
+
+func applyEnvValue(name string, v reflect.Value) error {
+	if v.Kind() == reflect.Struct {
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			tag := strings.Split(field.Tag.Get("yaml"), ",")[0]
+			if tag == "" || tag == "-" {
+				continue
+			}
+
+			if err := applyEnvValue(name+"_"+strings.ToUpper(tag), v.Field(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	raw, ok := os.LookupEnv(name)
+	if !ok {
+		return nil
+	}
+
+	if err := setFromString(v, raw); err != nil {
+		return fmt.Errorf("环境变量 %s 的值 %q 无效: %w", name, raw, err)
+	}
+	return nil
+}
+
+// setFromString 将字符串解析为v对应的类型并赋值
+func setFromString(v reflect.Value, raw string) error {
+	if v.Type() == durationType {
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(raw)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("不支持通过环境变量设置该类型")
+		}
+		items := splitList(raw)
+		v.Set(reflect.ValueOf(items))
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String || v.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("不支持通过环境变量设置该类型")
+		}
+		m := make(map[string]string)
+		for _, item := range splitList(raw) {
+			key, value, found := strings.Cut(item, "=")
+			if !found {
+				return fmt.Errorf("映射项 %q 缺少 '='", item)
+			}
+			m[strings.TrimSpace(key)] = strings.TrimSpace(value)
+		}
+		v.Set(reflect.ValueOf(m))
+	default:
+		return fmt.Errorf("不支持通过环境变量设置该类型")
+	}
+
+	return nil
+}
+
+// splitList 按逗号拆分并去除空白项
+func splitList(raw string) []string {
+	items := []string{}
+	for _, item := range strings.Split(raw, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// validationErrors 收集校验错误，便于一次性报告所有问题
+type validationErrors []string
+
+func (ve *validationErrors) add(field string, format string, args ...interface{}) {
+	*ve = append(*ve, fmt.Sprintf("配置项 %s 无效: %s", field, fmt.Sprintf(format, args...)))
+}
+
+func (ve validationErrors) err() error {
+	if len(ve) == 0 {
+		return nil
+	}
+	return errors.New(strings.Join(ve, "; "))
+}
+
+// KnownCommandTypes 是客户端支持的命令类型
+var KnownCommandTypes = []string{"shell", "collect_info", "update", "service", "integrity"}
+
+// CommandPolicy 定义允许执行的命令类型
+type CommandPolicy struct {
+	AllowedCommandTypes []string `yaml:"allowed_command_types"`
+}
+
+// Allows 判断命令类型是否被策略允许
+func (p CommandPolicy) Allows(cmdType string) bool {
+	for _, t := range p.AllowedCommandTypes {
+		if t == cmdType {
+			return true
+		}
+	}
+	return false
+}
+
+func (p CommandPolicy) validate(field string, ve *validationErrors) {
+	for _, t := range p.AllowedCommandTypes {
+		if !contains(KnownCommandTypes, t) {
+			ve.add(field, "未知的命令类型 %q，可选值: %s", t, strings.Join(KnownCommandTypes, ", "))
+		}
+	}
+}
+
+// validateHostPort 校验 "host:port" 形式的地址，IPv6地址需要用方括号括起来，例如 "[::1]:50025"
+func validateHostPort(field string, addr string, ve *validationErrors) {
+	_, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		if !strings.HasPrefix(addr, "[") && strings.Count(addr, ":") > 1 {
+			ve.add(field, "IPv6地址需要用方括号括起来，例如 [2001:db8::1]:50025，当前为 %q", addr)
+			return
+		}
+		ve.add(field, "%v", err)
+		return
+	}
+
+	if n, err := strconv.Atoi(port); err != nil || n < 0 || n > 65535 {
+		ve.add(field, "无效的端口 %q", port)
+	}
+}
+
+func contains(items []string, item string) bool {
+	for _, i := range items {
+		if i == item {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,68 @@
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+)
+
+// LogConfig 日志转发配置
+type LogConfig struct {
+	Files     []LogFileConfig `yaml:"files"`      // 跟踪的日志文件
+	Journald  JournalConfig   `yaml:"journald"`   // systemd journal
+	StateFile string          `yaml:"state_file"` // 保存各日志源已转发位置的文件，客户端重启后从该位置继续
+}
+
+// LogFileConfig 单个日志文件的跟踪配置
+type LogFileConfig struct {
+	Path    string   `yaml:"path"`    // 绝对路径，支持通配符，之后新出现的匹配文件也会被跟踪；通配符不应匹配轮转后的旧文件（如 *.log.1），否则会重复转发
+	Include []string `yaml:"include"` // 正则表达式，为空时转发所有行，否则只转发匹配任一表达式的行
+	Exclude []string `yaml:"exclude"` // 正则表达式，匹配任一表达式的行不转发
+}
+
+// JournalConfig journald日志的转发配置，通过 journalctl 读取
+type JournalConfig struct {
+	Enabled bool     `yaml:"enabled"`
+	Units   []string `yaml:"units"`   // 只转发这些单元的日志，为空时转发全部
+	Include []string `yaml:"include"` // 同 LogFileConfig.Include
+	Exclude []string `yaml:"exclude"` // 同 LogFileConfig.Exclude
+}
+
+// Enabled 判断是否配置了任何日志源
+func (c LogConfig) Enabled() bool {
+	return len(c.Files) > 0 || c.Journald.Enabled
+}
+
+func (c LogConfig) validate(ve *validationErrors) {
+	paths := make(map[string]bool)
+	for i, file := range c.Files {
+		field := fmt.Sprintf("logs.files[%d]", i)
+		if !filepath.IsAbs(file.Path) {
+			ve.add(field+".path", "%q 必须为绝对路径", file.Path)
+		} else if _, err := filepath.Match(file.Path, ""); err != nil {
+			ve.add(field+".path", "%q: %v", file.Path, err)
+		}
+		if paths[file.Path] {
+			ve.add(field+".path", "%q 重复", file.Path)
+		}
+		paths[file.Path] = true
+		validateRegexps(field+".include", file.Include, ve)
+		validateRegexps(field+".exclude", file.Exclude, ve)
+	}
+
+	validateRegexps("logs.journald.include", c.Journald.Include, ve)
+	validateRegexps("logs.journald.exclude", c.Journald.Exclude, ve)
+
+	if c.Enabled() && c.StateFile == "" {
+		ve.add("logs.state_file", "配置了日志源时不能为空")
+	}
+}
+
+// validateRegexps 检查一组正则表达式是否合法
+func validateRegexps(field string, exprs []string, ve *validationErrors) {
+	for _, expr := range exprs {
+		if _, err := regexp.Compile(expr); err != nil {
+			ve.add(field, "无效的正则表达式 %q: %v", expr, err)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// ServerConfig 服务端配置
+type ServerConfig struct {
+	Listen   string          `yaml:"listen"`
+	TLS      ServerTLSConfig `yaml:"tls"`
+	Storage  StorageConfig   `yaml:"storage"`
+	Policies CommandPolicy   `yaml:"policies"`
+
+	AgentConfig   AgentConfigSet     `yaml:"agent_config"` // 下发给客户端的配置
+	Notifications NotificationConfig `yaml:"notifications"`
+	AlertRules    []AlertRule        `yaml:"alert_rules"` // 指标阈值告警规则
+	LogRules      []LogRule          `yaml:"log_rules"`   // 日志告警规则
+	Advisories    AdvisoryConfig     `yaml:"advisories"`  // 用于匹配已安装软件包的安全公告
+}
+
+// NotificationConfig 告警通知配置，告警触发和恢复时除写日志外还会推送到各webhook
+type NotificationConfig struct {
+	Webhooks []string      `yaml:"webhooks"`
+	Timeout  time.Duration `yaml:"timeout"`
+}
+
+// StorageConfig 服务端内存存储相关配置
+type StorageConfig struct {
+	MaxCommandResults int           `yaml:"max_command_results"` // 最多保留的命令结果数
+	ClientExpiry      time.Duration `yaml:"client_expiry"`       // 超过该时间未上报的客户端将被移除，0表示不移除
+	MaxEvents         int           `yaml:"max_events"`          // 最多保留的事件数和已恢复告警数
+	MaxCheckHistory   int           `yaml:"max_check_history"`   // 每个客户端最多保留的检查状态变化记录数
+	MaxPackageHistory int           `yaml:"max_package_history"` // 每个客户端最多保留的软件包变化记录数
+	MaxLogLines       int           `yaml:"max_log_lines"`       // 每个客户端最多保留的日志行数
+	LogRetention      time.Duration `yaml:"log_retention"`       // 日志保留时长，0表示只按行数限制
+}
+
+// DefaultServerConfig 返回服务端默认配置
+func DefaultServerConfig() *ServerConfig {
+	return &ServerConfig{
+		Listen: ":50025",
+		Storage: StorageConfig{
+			MaxCommandResults: 1000,
+			MaxEvents:         1000,
+			MaxCheckHistory:   100,
+			MaxPackageHistory: 500,
+			MaxLogLines:       10000,
+			LogRetention:      24 * time.Hour,
+		},
+		Notifications: NotificationConfig{
+			Timeout: 10 * time.Second,
+		},
+		Advisories: AdvisoryConfig{
+			AlertMinSeverity: "high",
+		},
+		Policies: CommandPolicy{
+			AllowedCommandTypes: append([]string{}, KnownCommandTypes...),
+		},
+	}
+}
+
+// LoadServerConfig 加载服务端配置：默认值 < 配置文件 < 环境变量(GOMONITOR_SERVER_*)
+func LoadServerConfig(path string) (*ServerConfig, error) {
+	cfg := DefaultServerConfig()
+
+	if err := loadFile(path, cfg); err != nil {
+		return nil, err
+	}
+
+	if err := applyEnv(EnvPrefix+"SERVER", cfg); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// Validate 校验服务端配置
+func (c *ServerConfig) Validate() error {
+	var ve validationErrors
+
+	validateHostPort("listen", c.Listen, &ve)
+
+	c.TLS.validate(&ve)
+
+	if c.Storage.MaxCommandResults <= 0 {
+		ve.add("storage.max_command_results", "必须大于0")
+	}
+	if c.Storage.ClientExpiry < 0 {
+		ve.add("storage.client_expiry", "不能为负数")
+	}
+	if c.Storage.MaxEvents <= 0 {
+		ve.add("storage.max_events", "必须大于0")
+	}
+	if c.Storage.MaxCheckHistory <= 0 {
+		ve.add("storage.max_check_history", "必须大于0")
+	}
+	if c.Storage.MaxPackageHistory <= 0 {
+		ve.add("storage.max_package_history", "必须大于0")
+	}
+	if c.Storage.MaxLogLines <= 0 {
+		ve.add("storage.max_log_lines", "必须大于0")
+	}
+	if c.Storage.LogRetention < 0 {
+		ve.add("storage.log_retention", "不能为负数")
+	}
+
+	for i, webhook := range c.Notifications.Webhooks {
+		if u, err := url.Parse(webhook); err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			ve.add(fmt.Sprintf("notifications.webhooks[%d]", i), "必须是http或https地址: %q", webhook)
+		}
+	}
+	if c.Notifications.Timeout <= 0 {
+		ve.add("notifications.timeout", "必须大于0")
+	}
+
+	c.Policies.validate("policies.allowed_command_types", &ve)
+	c.AgentConfig.validate(&ve)
+	validateAlertRules(c.AlertRules, &ve)
+	validateLogRules(c.LogRules, &ve)
+	c.Advisories.validate(&ve)
+
+	return ve.err()
+}
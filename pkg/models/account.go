@@ -0,0 +1,130 @@
+package models
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"GoMonitor/proto"
+)
+
+// RootAccess 是跨客户端列出的一个拥有root权限的账户
+type RootAccess struct {
+	ClientID string
+	Hostname string
+	Account  *proto.LocalAccount
+}
+
+// RootReason 说明账户拥有root权限的原因
+func RootReason(account *proto.LocalAccount) string {
+	var reasons []string
+	if account.GetUid() == 0 {
+		reasons = append(reasons, "UID 0")
+	}
+	if len(account.GetSudo()) > 0 {
+		reasons = append(reasons, "sudo: "+strings.Join(account.GetSudo(), "; "))
+	}
+	return strings.Join(reasons, ", ")
+}
+
+// FormatAccount 将账户格式化为一行，例如 "alice uid=1000 gid=1000 /bin/bash 组: alice,sudo [root]"
+func FormatAccount(account *proto.LocalAccount) string {
+	s := fmt.Sprintf("%s uid=%d gid=%d %s 组: %s", account.GetName(), account.GetUid(), account.GetGid(),
+		account.GetShell(), strings.Join(account.GetGroups(), ","))
+	if account.GetPasswordChanged() > 0 {
+		s += " 密码修改于 " + time.Unix(account.GetPasswordChanged(), 0).UTC().Format("2006-01-02")
+		if account.GetPasswordMaxDays() > 0 {
+			s += fmt.Sprintf("（有效期 %d 天）", account.GetPasswordMaxDays())
+		}
+	}
+	if account.GetExpires() > 0 {
+		s += " 账户过期于 " + time.Unix(account.GetExpires(), 0).UTC().Format("2006-01-02")
+	}
+	if account.GetRoot() {
+		s += " [root]"
+	}
+	return s
+}
+
+// DiffAccount 列出账户前后两次上报之间发生变化的属性，密码修改时间除外
+func DiffAccount(before, after *proto.LocalAccount) []string {
+	var diffs []string
+	if before.GetUid() != after.GetUid() || before.GetGid() != after.GetGid() {
+		diffs = append(diffs, fmt.Sprintf("uid/gid %d/%d -> %d/%d", before.GetUid(), before.GetGid(), after.GetUid(), after.GetGid()))
+	}
+	if before.GetShell() != after.GetShell() {
+		diffs = append(diffs, fmt.Sprintf("shell %s -> %s", before.GetShell(), after.GetShell()))
+	}
+	if before.GetHome() != after.GetHome() {
+		diffs = append(diffs, fmt.Sprintf("主目录 %s -> %s", before.GetHome(), after.GetHome()))
+	}
+	if added, removed := diffStrings(before.GetGroups(), after.GetGroups()); len(added)+len(removed) > 0 {
+		diffs = append(diffs, "组"+formatStringDiff(added, removed))
+	}
+	if added, removed := diffStrings(before.GetSudo(), after.GetSudo()); len(added)+len(removed) > 0 {
+		diffs = append(diffs, "sudo"+formatStringDiff(added, removed))
+	}
+	if before.GetExpires() != after.GetExpires() {
+		diffs = append(diffs, "账户过期时间已修改")
+	}
+	if before.GetRoot() != after.GetRoot() {
+		if after.GetRoot() {
+			diffs = append(diffs, "获得root权限")
+		} else {
+			diffs = append(diffs, "失去root权限")
+		}
+	}
+	return diffs
+}
+
+// diffStrings 返回after相对于before新增和删除的项
+func diffStrings(before, after []string) (added, removed []string) {
+	for _, s := range after {
+		if !slices.Contains(before, s) {
+			added = append(added, s)
+		}
+	}
+	for _, s := range before {
+		if !slices.Contains(after, s) {
+			removed = append(removed, s)
+		}
+	}
+	return added, removed
+}
+
+// formatStringDiff 将新增和删除的项格式化为 " +a +b -c"
+func formatStringDiff(added, removed []string) string {
+	var sb strings.Builder
+	for _, s := range added {
+		sb.WriteString(" +" + s)
+	}
+	for _, s := range removed {
+		sb.WriteString(" -" + s)
+	}
+	return sb.String()
+}
+
+// FormatAccountInventory 将账户和组清单格式化为多行文本
+func FormatAccountInventory(inventory *proto.AccountInventory) string {
+	if inventory == nil {
+		return "没有账户信息（系统不支持或无法读取 /etc/passwd）\n"
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("账户: %d\n", len(inventory.GetAccounts())))
+	for _, account := range inventory.GetAccounts() {
+		sb.WriteString("  " + FormatAccount(account) + "\n")
+		for _, rule := range account.GetSudo() {
+			sb.WriteString("    sudo: " + rule + "\n")
+		}
+	}
+	sb.WriteString(fmt.Sprintf("组: %d\n", len(inventory.GetGroups())))
+	for _, group := range inventory.GetGroups() {
+		if len(group.GetMembers()) == 0 {
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("  %s gid=%d 成员: %s\n", group.GetName(), group.GetGid(), strings.Join(group.GetMembers(), ",")))
+	}
+	return sb.String()
+}
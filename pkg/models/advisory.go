@@ -0,0 +1,248 @@
+package models
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"GoMonitor/pkg/utils"
+	"GoMonitor/proto"
+)
+
+// 安全公告严重级别，从低到高排列
+var AdvisorySeverities = []string{"unknown", "low", "medium", "high", "critical"}
+
+// Advisory 是一条OSV格式（https://ossf.github.io/osv-schema/）的安全公告，只保留匹配所需的字段
+type Advisory struct {
+	ID               string            `json:"id"`
+	Summary          string            `json:"summary"`
+	Aliases          []string          `json:"aliases"`
+	Withdrawn        string            `json:"withdrawn"`
+	Severity         []OSVSeverity     `json:"severity"`
+	Affected         []AffectedPackage `json:"affected"`
+	DatabaseSpecific json.RawMessage   `json:"database_specific"`
+}
+
+// OSVSeverity 是公告的评分，type为CVSS_V3、CVSS_V4或发行版自定义的类型（例如Ubuntu）
+type OSVSeverity struct {
+	Type  string `json:"type"`
+	Score string `json:"score"`
+}
+
+// AffectedPackage 是公告影响的一个软件包及其受影响的版本
+type AffectedPackage struct {
+	Package struct {
+		Ecosystem string `json:"ecosystem"` // 例如 "Debian:12"、"PyPI"
+		Name      string `json:"name"`
+	} `json:"package"`
+	Ranges            []AffectedRange `json:"ranges"`
+	Versions          []string        `json:"versions"`
+	EcosystemSpecific json.RawMessage `json:"ecosystem_specific"`
+	DatabaseSpecific  json.RawMessage `json:"database_specific"`
+}
+
+// AffectedRange 是一段受影响的版本范围，由按版本排序的事件描述
+type AffectedRange struct {
+	Type   string       `json:"type"` // ECOSYSTEM、SEMVER 或 GIT
+	Events []RangeEvent `json:"events"`
+}
+
+// RangeEvent 是版本范围中的一个事件，每个事件只设置一个字段
+type RangeEvent struct {
+	Introduced   string `json:"introduced"`
+	Fixed        string `json:"fixed"`
+	LastAffected string `json:"last_affected"`
+	Limit        string `json:"limit"`
+}
+
+// VulnerabilityFinding 是客户端上一个已安装软件包命中的一条公告
+type VulnerabilityFinding struct {
+	AdvisoryID   string
+	Aliases      []string
+	Summary      string
+	Severity     string
+	Package      *proto.Package
+	FixedVersion string // 为空表示尚无修复版本
+}
+
+// AdvisoryImpact 汇总一条公告在所有客户端上的影响
+type AdvisoryImpact struct {
+	AdvisoryID string
+	Summary    string
+	Severity   string
+	Hosts      []string // 受影响的主机名
+	Packages   []string // 受影响的软件包，例如 "openssl 3.0.11-1~deb12u1"
+}
+
+// VulnerabilityReport 是所有客户端的漏洞汇总
+type VulnerabilityReport struct {
+	AdvisoryCount int // 已加载的公告数量
+	Impacts       []*AdvisoryImpact
+}
+
+// SeverityRank 返回严重级别的排序值，未知级别为0
+func SeverityRank(severity string) int {
+	for i, s := range AdvisorySeverities {
+		if s == severity {
+			return i
+		}
+	}
+	return 0
+}
+
+// NormalizedSeverity 返回公告的严重级别（AdvisorySeverities之一）
+// 依次读取database_specific.severity（GitHub公告等）、发行版自定义的评分（例如Ubuntu的优先级），都没有时为unknown
+// 只有CVSS向量的公告不计算分数
+func (a *Advisory) NormalizedSeverity() string {
+	var specific struct {
+		Severity string `json:"severity"`
+	}
+	if len(a.DatabaseSpecific) > 0 && json.Unmarshal(a.DatabaseSpecific, &specific) == nil && specific.Severity != "" {
+		return normalizeSeverity(specific.Severity)
+	}
+	for _, affected := range a.Affected {
+		for _, raw := range []json.RawMessage{affected.EcosystemSpecific, affected.DatabaseSpecific} {
+			if len(raw) > 0 && json.Unmarshal(raw, &specific) == nil && specific.Severity != "" {
+				return normalizeSeverity(specific.Severity)
+			}
+		}
+	}
+	for _, severity := range a.Severity {
+		if !strings.HasPrefix(severity.Type, "CVSS") {
+			return normalizeSeverity(severity.Score)
+		}
+	}
+	return "unknown"
+}
+
+// normalizeSeverity 把各数据源的级别名称统一为AdvisorySeverities之一
+func normalizeSeverity(severity string) string {
+	switch strings.ToLower(strings.TrimSpace(severity)) {
+	case "critical":
+		return "critical"
+	case "high", "important":
+		return "high"
+	case "medium", "moderate":
+		return "medium"
+	case "low", "negligible":
+		return "low"
+	default:
+		return "unknown"
+	}
+}
+
+// Ecosystem 返回生态系统名称和发行版版本，例如 "Debian:12" 返回 ("Debian", "12")
+// "Ubuntu:22.04:LTS" 返回 ("Ubuntu", "22.04")；没有版本时release为空
+func (p *AffectedPackage) Ecosystem() (name string, release string) {
+	parts := strings.Split(p.Package.Ecosystem, ":")
+	if len(parts) > 1 {
+		release = parts[1]
+	}
+	return parts[0], release
+}
+
+// Affects 判断版本是否受影响，受影响时同时返回修复版本（没有修复版本时为空）
+// compare为该生态系统的版本比较函数；GIT类型的范围无法用版本号判断，会被忽略
+func (p *AffectedPackage) Affects(version string, compare func(a, b string) int) (bool, string) {
+	for _, v := range p.Versions {
+		if v == version {
+			return true, fixedVersion(p.Ranges, version, compare)
+		}
+	}
+
+	for _, r := range p.Ranges {
+		cmp := rangeCompare(r, compare)
+		if cmp == nil {
+			continue
+		}
+		if affected, fixed := rangeAffects(r.Events, version, cmp); affected {
+			return true, fixed
+		}
+	}
+	return false, ""
+}
+
+// rangeCompare 返回范围使用的版本比较函数，无法比较的范围类型返回nil
+func rangeCompare(r AffectedRange, ecosystem func(a, b string) int) func(a, b string) int {
+	switch r.Type {
+	case "ECOSYSTEM":
+		return ecosystem
+	case "SEMVER":
+		return utils.CompareSemver
+	default:
+		return nil
+	}
+}
+
+// rangeAffects 按OSV规范评估范围：事件按版本排序后依次处理，introduced进入受影响区间，fixed和last_affected离开
+func rangeAffects(events []RangeEvent, version string, compare func(a, b string) int) (bool, string) {
+	sorted := append([]RangeEvent{}, events...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return compareEvents(sorted[i], sorted[j], compare) < 0
+	})
+
+	affected := false
+	fixed := ""
+	for _, event := range sorted {
+		switch {
+		case event.Introduced != "":
+			if event.Introduced == "0" || compare(version, event.Introduced) >= 0 {
+				affected = true
+				fixed = ""
+			}
+		case event.Fixed != "":
+			if compare(version, event.Fixed) >= 0 {
+				affected = false
+			} else if affected && fixed == "" {
+				fixed = event.Fixed
+			}
+		case event.LastAffected != "":
+			if compare(version, event.LastAffected) > 0 {
+				affected = false
+			}
+		case event.Limit != "":
+			if compare(version, event.Limit) >= 0 {
+				affected = false
+			}
+		}
+	}
+	return affected, fixed
+}
+
+// compareEvents 比较两个事件的版本，introduced为"0"时排在最前
+func compareEvents(a, b RangeEvent, compare func(a, b string) int) int {
+	va, vb := eventVersion(a), eventVersion(b)
+	switch {
+	case va == vb:
+		return 0
+	case va == "0" && a.Introduced != "":
+		return -1
+	case vb == "0" && b.Introduced != "":
+		return 1
+	}
+	return compare(va, vb)
+}
+
+// eventVersion 返回事件中设置的版本
+func eventVersion(e RangeEvent) string {
+	for _, v := range []string{e.Introduced, e.Fixed, e.LastAffected, e.Limit} {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// fixedVersion 返回首个高于version的修复版本，用于通过versions列表命中的情况
+func fixedVersion(ranges []AffectedRange, version string, compare func(a, b string) int) string {
+	for _, r := range ranges {
+		cmp := rangeCompare(r, compare)
+		if cmp == nil {
+			continue
+		}
+		if _, fixed := rangeAffects(r.Events, version, cmp); fixed != "" {
+			return fixed
+		}
+	}
+	return ""
+}
@@ -0,0 +1,82 @@
+package models
+
+import (
+	"testing"
+
+	"GoMonitor/pkg/utils"
+)
+
+func TestRangeAffects(t *testing.T) {
+	multiple := []RangeEvent{
+		{Introduced: "1.0"}, {Fixed: "1.2"},
+		{Introduced: "2.0"}, {Fixed: "2.3"},
+	}
+	tests := []struct {
+		name     string
+		events   []RangeEvent
+		version  string
+		affected bool
+		fixed    string
+	}{
+		{"从0开始受影响", []RangeEvent{{Introduced: "0"}, {Fixed: "1.2"}}, "0.1", true, "1.2"},
+		{"从0开始已修复", []RangeEvent{{Introduced: "0"}, {Fixed: "1.2"}}, "1.2", false, ""},
+		{"尚无修复版本", []RangeEvent{{Introduced: "0"}}, "9.9", true, ""},
+		{"多段范围之前", multiple, "0.9", false, ""},
+		{"多段范围第一段", multiple, "1.1", true, "1.2"},
+		{"多段范围之间", multiple, "1.5", false, ""},
+		{"多段范围第二段", multiple, "2.2", true, "2.3"},
+		{"多段范围之后", multiple, "2.3", false, ""},
+		{"事件乱序", []RangeEvent{{Fixed: "2.3"}, {Introduced: "2.0"}, {Fixed: "1.2"}, {Introduced: "1.0"}}, "2.1", true, "2.3"},
+		{"last_affected本身受影响", []RangeEvent{{Introduced: "0"}, {LastAffected: "1.4"}}, "1.4", true, ""},
+		{"last_affected之后", []RangeEvent{{Introduced: "0"}, {LastAffected: "1.4"}}, "1.4.1", false, ""},
+		{"limit之前", []RangeEvent{{Introduced: "1.0"}, {Limit: "2.0"}}, "1.9", true, ""},
+		{"limit本身", []RangeEvent{{Introduced: "1.0"}, {Limit: "2.0"}}, "2.0", false, ""},
+		{"引入之前", []RangeEvent{{Introduced: "3.0"}}, "2.9", false, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			affected, fixed := rangeAffects(tt.events, tt.version, utils.CompareDebianVersions)
+			if affected != tt.affected || fixed != tt.fixed {
+				t.Fatalf("rangeAffects(%q) = (%v, %q), 期望 (%v, %q)", tt.version, affected, fixed, tt.affected, tt.fixed)
+			}
+		})
+	}
+}
+
+func TestAffectedPackageAffects(t *testing.T) {
+	pkg := AffectedPackage{
+		Ranges: []AffectedRange{
+			{Type: "GIT", Events: []RangeEvent{{Introduced: "0"}, {Fixed: "abc123"}}},
+			{Type: "ECOSYSTEM", Events: []RangeEvent{{Introduced: "0"}, {Fixed: "3.0.11-1~deb12u2"}}},
+		},
+		Versions: []string{"3.0.13-1"},
+	}
+
+	tests := []struct {
+		version  string
+		affected bool
+		fixed    string
+	}{
+		{"3.0.11-1~deb12u1", true, "3.0.11-1~deb12u2"},
+		{"3.0.11-1~deb12u2", false, ""},
+		{"3.0.11-1", false, ""},
+		// 通过versions列表命中，修复版本不高于它时不返回
+		{"3.0.13-1", true, ""},
+	}
+	for _, tt := range tests {
+		affected, fixed := pkg.Affects(tt.version, utils.CompareDebianVersions)
+		if affected != tt.affected || fixed != tt.fixed {
+			t.Errorf("Affects(%q) = (%v, %q), 期望 (%v, %q)", tt.version, affected, fixed, tt.affected, tt.fixed)
+		}
+	}
+
+	// 按dpkg规则1.0.0-rc.1晚于1.0.0，按语义化版本则早于1.0.0，不在范围内
+	semver := AffectedPackage{Ranges: []AffectedRange{{Type: "SEMVER", Events: []RangeEvent{{Introduced: "1.0.0"}, {Fixed: "1.0.2"}}}}}
+	if affected, _ := semver.Affects("1.0.0-rc.1", utils.CompareDebianVersions); affected {
+		t.Error("SEMVER范围应按语义化版本比较")
+	}
+	if affected, fixed := semver.Affects("1.0.1", utils.CompareDebianVersions); !affected || fixed != "1.0.2" {
+		t.Errorf("Affects(1.0.1) = (%v, %q)", affected, fixed)
+	}
+}
@@ -0,0 +1,50 @@
+package models
+
+import "time"
+
+// 事件和告警的严重级别
+const (
+	SeverityInfo     = "info"
+	SeverityWarning  = "warning"
+	SeverityCritical = "critical"
+)
+
+// AlertSeverityRank 返回事件和告警严重级别的排序值，critical > warning > info，未知级别为0
+func AlertSeverityRank(severity string) int {
+	switch severity {
+	case SeverityCritical:
+		return 3
+	case SeverityWarning:
+		return 2
+	case SeverityInfo:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Event 记录客户端上发生的一次状态变化
+type Event struct {
+	ID        string
+	ClientID  string
+	Hostname  string
+	Type      string // 事件类型，例如 "check_state"
+	Severity  string
+	Message   string
+	Timestamp time.Time
+}
+
+// Alert 表示一个持续存在的异常状态，由唯一的 (ClientID, Key) 标识
+type Alert struct {
+	ID         string
+	ClientID   string
+	Hostname   string
+	Key        string // 告警来源，例如 "check:disk_root"
+	Severity   string
+	Message    string
+	Details    []string // 附加信息，例如触发告警的日志行
+	Firing     bool
+	StartedAt  time.Time
+	UpdatedAt  time.Time
+	ResolvedAt time.Time
+}
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"GoMonitor/proto"
+)
+
+// CheckStatus 保存客户端上某个检查的当前状态
+type CheckStatus struct {
+	Result *proto.CheckResult
+	Since  time.Time // 进入当前状态的时间
+}
+
+// CheckStateChange 记录检查状态的一次变化
+type CheckStateChange struct {
+	CheckName string
+	From      proto.CheckState
+	To        proto.CheckState
+	Output    string
+	Timestamp time.Time
+}
+
+// CheckStateName 返回检查状态的Nagios名称，例如 CHECK_WARNING 返回 WARNING
+func CheckStateName(state proto.CheckState) string {
+	return strings.TrimPrefix(state.String(), "CHECK_")
+}
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"net"
 	"time"
 
 	"GoMonitor/proto"
@@ -8,11 +9,37 @@ import (
 
 // ClientInfo 存储客户端的详细信息
 type ClientInfo struct {
-	ID         string
-	Hostname   string
-	IPAddress  string
-	MACAddress string
-	OSInfo     string
-	LastSeen   time.Time
-	Info       *proto.SystemInfo
+	ID          string
+	Hostname    string
+	IPAddress   string             // 客户端上报的主地址
+	IPAddresses []*proto.IPAddress // 客户端上报的所有地址
+	MACAddress  string
+	OSInfo      string
+	Labels      map[string]string
+	LastSeen    time.Time
+	Info        *proto.SystemInfo
+
+	Inventory          *proto.HostInventory // 最近一次上报的硬件和系统清单
+	InventoryUpdatedAt time.Time            // 清单最近一次发生变化的时间
+
+	ObservedAddress string    // 服务端观测到的对端地址，客户端位于NAT之后时与上报的地址不同
+	ObservedAt      time.Time // 最近一次观测到对端地址的时间
+
+	DesiredConfig        *proto.AgentConfig // 服务端期望客户端应用的配置
+	AppliedConfigVersion int64              // 客户端确认已应用的配置版本
+	ConfigError          string             // 客户端应用配置失败时的错误信息
+}
+
+// BehindNAT 判断观测到的地址是否不在客户端上报的地址中，通常表示客户端位于NAT或代理之后
+func (c *ClientInfo) BehindNAT() bool {
+	ip := net.ParseIP(c.ObservedAddress)
+	if ip == nil {
+		return false
+	}
+	return !ip.Equal(net.ParseIP(c.IPAddress)) && !HasIPAddress(c.IPAddresses, ip)
+}
+
+// ConfigDrifted 判断客户端当前应用的配置是否落后于服务端期望的配置
+func (c *ClientInfo) ConfigDrifted() bool {
+	return c.DesiredConfig != nil && c.DesiredConfig.Version != c.AppliedConfigVersion
 }
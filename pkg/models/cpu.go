@@ -0,0 +1,14 @@
+package models
+
+import "GoMonitor/proto"
+
+// LoadAverages 返回1、5、15分钟平均负载
+// 旧版本客户端只上报乘以100后的整数字段，通过是否上报logical_cores区分新旧版本
+func LoadAverages(info *proto.CPUInfo) (float64, float64, float64) {
+	if info.GetLogicalCores() > 0 {
+		return info.GetLoad_1M(), info.GetLoad_5M(), info.GetLoad_15M()
+	}
+	return float64(info.GetLoadAverage_1M()) / 100,
+		float64(info.GetLoadAverage_5M()) / 100,
+		float64(info.GetLoadAverage_15M()) / 100
+}
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+
+	"GoMonitor/proto"
+)
+
+// ScheduledJobMatch 是跨客户端列出的一个计划任务
+type ScheduledJobMatch struct {
+	ClientID string
+	Hostname string
+	Job      *proto.ScheduledJob
+}
+
+// ScheduledJobKey 返回计划任务的标识，来源、文件、用户、执行时间和命令都相同的任务视为同一个
+func ScheduledJobKey(job *proto.ScheduledJob) string {
+	return strings.Join([]string{job.Source, job.File, job.User, job.Schedule, job.Command}, "\x00")
+}
+
+// FormatScheduledJob 将计划任务格式化为一行，例如 "[cron.d] */5 * * * * root /usr/bin/backup (/etc/cron.d/backup)"
+func FormatScheduledJob(job *proto.ScheduledJob) string {
+	return fmt.Sprintf("[%s] %s %s %s (%s)", job.GetSource(), job.GetSchedule(), job.GetUser(), job.GetCommand(), job.GetFile())
+}
+
+// FormatScheduledJobs 将计划任务清单格式化为多行文本
+func FormatScheduledJobs(inventory *proto.ScheduledJobInventory) string {
+	if inventory == nil {
+		return "没有计划任务信息（系统不支持或未找到cron配置）\n"
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("计划任务: %d\n", len(inventory.GetJobs())))
+	for _, job := range inventory.GetJobs() {
+		sb.WriteString("  " + FormatScheduledJob(job) + "\n")
+	}
+	return sb.String()
+}
@@ -0,0 +1,80 @@
+package models
+
+import (
+	"fmt"
+	"io/fs"
+	"strings"
+	"time"
+
+	"GoMonitor/proto"
+)
+
+// 文件相对于基线的变化类型
+const (
+	FileAdded    = "added"
+	FileModified = "modified"
+	FileDeleted  = "deleted"
+)
+
+// SameFileState 比较两个文件状态的内容、类型、权限、属主和链接目标
+// 修改时间不参与比较，只更新时间戳（例如touch）不算变化
+func SameFileState(a, b *proto.FileState) bool {
+	return a.GetSha256() == b.GetSha256() &&
+		a.GetMode() == b.GetMode() &&
+		a.GetUid() == b.GetUid() &&
+		a.GetGid() == b.GetGid() &&
+		a.GetSize() == b.GetSize() &&
+		a.GetLinkTarget() == b.GetLinkTarget()
+}
+
+// FormatFileState 将文件状态格式化为一行，例如 "-rw-r--r-- 0:0 1234字节 sha256:ab12cd34ef56"
+func FormatFileState(state *proto.FileState) string {
+	s := fmt.Sprintf("%s %d:%d %d字节", fs.FileMode(state.GetMode()), state.GetUid(), state.GetGid(), state.GetSize())
+	if state.GetLinkTarget() != "" {
+		s += " -> " + state.GetLinkTarget()
+	} else {
+		s += " sha256:" + shortHash(state)
+	}
+	return s + " 修改于 " + time.Unix(state.GetModTime(), 0).Format(time.RFC3339)
+}
+
+// FormatFileChange 描述文件相对于基线的变化，修改时只列出发生变化的属性
+func FormatFileChange(change *proto.FileChange) string {
+	before, after := change.GetBefore(), change.GetAfter()
+	switch change.GetAction() {
+	case FileAdded:
+		return fmt.Sprintf("新增 %s (%s)", change.GetPath(), FormatFileState(after))
+	case FileDeleted:
+		return fmt.Sprintf("删除 %s (原为 %s)", change.GetPath(), FormatFileState(before))
+	}
+
+	var diffs []string
+	if before.GetSha256() != after.GetSha256() {
+		diffs = append(diffs, fmt.Sprintf("内容 %s -> %s", shortHash(before), shortHash(after)))
+	}
+	if before.GetSize() != after.GetSize() {
+		diffs = append(diffs, fmt.Sprintf("大小 %d -> %d", before.GetSize(), after.GetSize()))
+	}
+	if before.GetMode() != after.GetMode() {
+		diffs = append(diffs, fmt.Sprintf("权限 %s -> %s", fs.FileMode(before.GetMode()), fs.FileMode(after.GetMode())))
+	}
+	if before.GetUid() != after.GetUid() || before.GetGid() != after.GetGid() {
+		diffs = append(diffs, fmt.Sprintf("属主 %d:%d -> %d:%d", before.GetUid(), before.GetGid(), after.GetUid(), after.GetGid()))
+	}
+	if before.GetLinkTarget() != after.GetLinkTarget() {
+		diffs = append(diffs, fmt.Sprintf("链接 %s -> %s", before.GetLinkTarget(), after.GetLinkTarget()))
+	}
+	return fmt.Sprintf("修改 %s: %s", change.GetPath(), strings.Join(diffs, ", "))
+}
+
+// shortHash 返回哈希的前12位，内容无法读取时返回原因
+func shortHash(state *proto.FileState) string {
+	switch {
+	case state.GetError() != "":
+		return "(" + state.GetError() + ")"
+	case len(state.GetSha256()) > 12:
+		return state.GetSha256()[:12]
+	default:
+		return state.GetSha256()
+	}
+}
@@ -0,0 +1,66 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"GoMonitor/proto"
+)
+
+// InventoryChanges 比较两份硬件和系统清单，返回发生变化的项的描述，没有变化时返回nil
+func InventoryChanges(old, new *proto.HostInventory) []string {
+	var changes []string
+	compare := func(field, oldValue, newValue string) {
+		if oldValue != newValue {
+			changes = append(changes, fmt.Sprintf("%s从 %s 变为 %s", field, oldValue, newValue))
+		}
+	}
+
+	compare("内核", old.GetKernelVersion(), new.GetKernelVersion())
+	compare("发行版", old.GetDistro()+" "+old.GetDistroVersion(), new.GetDistro()+" "+new.GetDistroVersion())
+	compare("CPU", FormatInventoryCPU(old), FormatInventoryCPU(new))
+	compare("内存", formatGiB(old.GetTotalMemory()), formatGiB(new.GetTotalMemory()))
+	compare("磁盘", formatInventoryDisks(old.GetDisks()), formatInventoryDisks(new.GetDisks()))
+	compare("网卡", formatInventoryNICs(old.GetNics()), formatInventoryNICs(new.GetNics()))
+	compare("序列号", old.GetDmi().GetSerial(), new.GetDmi().GetSerial())
+	compare("BIOS版本", old.GetDmi().GetBiosVersion(), new.GetDmi().GetBiosVersion())
+	if old.GetBootTime() != new.GetBootTime() {
+		changes = append(changes, fmt.Sprintf("系统于 %s 重新启动", time.Unix(new.GetBootTime(), 0).Format(time.RFC3339)))
+	}
+	return changes
+}
+
+// FormatInventoryCPU 将CPU信息格式化为 "Intel Xeon ... (2插槽/16核/32线程)" 形式
+func FormatInventoryCPU(inv *proto.HostInventory) string {
+	if inv.GetCpuThreads() == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%s (%d插槽/%d核/%d线程)", inv.GetCpuModel(), inv.GetCpuSockets(), inv.GetCpuCores(), inv.GetCpuThreads())
+}
+
+// formatGiB 以GB为单位格式化字节数，0表示未知，返回空字符串
+func formatGiB(bytes int64) string {
+	if bytes == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%.1f GB", float64(bytes)/(1<<30))
+}
+
+// formatInventoryDisks 将磁盘列表格式化为 "sda 480.0 GB, nvme0n1 1863.0 GB" 形式
+func formatInventoryDisks(disks []*proto.InventoryDisk) string {
+	parts := make([]string, len(disks))
+	for i, disk := range disks {
+		parts[i] = disk.Name + " " + formatGiB(disk.SizeBytes)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// formatInventoryNICs 将网卡列表格式化为 "eth0 (52:54:00:12:34:56)" 形式
+func formatInventoryNICs(nics []*proto.InventoryNIC) string {
+	parts := make([]string, len(nics))
+	for i, nic := range nics {
+		parts[i] = fmt.Sprintf("%s (%s)", nic.Name, nic.MacAddress)
+	}
+	return strings.Join(parts, ", ")
+}
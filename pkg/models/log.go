@@ -0,0 +1,67 @@
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"GoMonitor/pkg/utils"
+)
+
+// LogEntry 是服务端保存的一行客户端日志
+type LogEntry struct {
+	Seq       int64 // 服务端接收顺序，全局递增
+	ClientID  string
+	Hostname  string
+	Source    string // 文件路径或 "journald:<单元>"
+	Timestamp time.Time
+	Line      string
+}
+
+// LogQuery 是日志搜索条件，所有条件都为可选
+type LogQuery struct {
+	ClientID string // 为空时搜索所有客户端
+	Source   string // 日志源，通配符或 "re:" 开头的正则表达式
+	Pattern  string // 正则表达式，匹配日志内容
+	AfterSeq int64  // 只返回序号大于该值的日志，用于实时跟踪
+	Limit    int    // 最多返回的条数（最新的），<=0表示不限制
+}
+
+// LogFilter 是编译后的日志搜索条件
+type LogFilter struct {
+	source  *utils.Matcher
+	pattern *regexp.Regexp
+}
+
+// Compile 编译搜索条件中的日志源和内容表达式
+func (q LogQuery) Compile() (*LogFilter, error) {
+	f := &LogFilter{}
+	if q.Source != "" {
+		m, err := utils.NewMatcher([]string{q.Source}, nil)
+		if err != nil {
+			return nil, fmt.Errorf("日志源: %w", err)
+		}
+		f.source = m
+	}
+	if q.Pattern != "" {
+		re, err := regexp.Compile(q.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("无效的正则表达式 %q: %w", q.Pattern, err)
+		}
+		f.pattern = re
+	}
+	return f, nil
+}
+
+// Match 判断日志是否满足日志源和内容条件
+func (f *LogFilter) Match(entry *LogEntry) bool {
+	if f.source != nil && !f.source.Match(entry.Source) {
+		return false
+	}
+	return f.pattern == nil || f.pattern.MatchString(entry.Line)
+}
+
+// FormatLogEntry 将日志格式化为一行，例如 "2024-01-02 15:04:05 web-1 /var/log/syslog: ..."
+func FormatLogEntry(entry *LogEntry) string {
+	return fmt.Sprintf("%s %s %s: %s", entry.Timestamp.Format("2006-01-02 15:04:05"), entry.Hostname, entry.Source, entry.Line)
+}
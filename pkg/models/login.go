@@ -0,0 +1,81 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"GoMonitor/proto"
+)
+
+// loginTimeFormat 是登录记录中时间的显示格式
+const loginTimeFormat = "2006-01-02 15:04:05"
+
+// FormatLoginSession 将登录会话格式化为一行，例如 "root pts/0 来自 10.0.0.5 2024-01-02 15:04:05 - 15:30:00 (26m0s)"
+func FormatLoginSession(session *proto.LoginSession) string {
+	s := session.GetUser() + " " + session.GetTty()
+	if session.GetHost() != "" {
+		s += " 来自 " + session.GetHost()
+	}
+
+	login := time.Unix(session.GetLoginTime(), 0)
+	s += " " + login.Format(loginTimeFormat)
+	if session.GetLogoutTime() == 0 {
+		return s
+	}
+	logout := time.Unix(session.GetLogoutTime(), 0)
+	return s + fmt.Sprintf(" - %s (%s)", logout.Format("15:04:05"), logout.Sub(login).Round(time.Second))
+}
+
+// FormatSSHLogin 将成功的SSH登录格式化为一行，例如 "2024-01-02 15:04:05 root 来自 10.0.0.5 (publickey)"
+func FormatSSHLogin(login *proto.SSHLogin) string {
+	return fmt.Sprintf("%s %s 来自 %s (%s)",
+		time.Unix(login.GetTimestamp(), 0).Format(loginTimeFormat), login.GetUser(), login.GetSourceIp(), login.GetMethod())
+}
+
+// FormatFailedLoginSource 将同一来源的失败登录格式化为一行
+func FormatFailedLoginSource(source *proto.FailedLoginSource) string {
+	from := source.GetSource()
+	if from == "" {
+		from = "本地"
+	}
+	return fmt.Sprintf("%s: %d 次，%s 至 %s，用户: %s", from, source.GetCount(),
+		time.Unix(source.GetFirstAttempt(), 0).Format(loginTimeFormat),
+		time.Unix(source.GetLastAttempt(), 0).Format(loginTimeFormat),
+		strings.Join(source.GetUsers(), ", "))
+}
+
+// FormatLoginInfo 将登录审计信息格式化为多行文本
+func FormatLoginInfo(info *proto.LoginInfo) string {
+	if info == nil {
+		return "没有登录记录（系统不支持或未找到 utmp/wtmp 和认证日志）\n"
+	}
+
+	var sb strings.Builder
+	window := time.Duration(info.GetWindowSeconds()) * time.Second
+
+	sb.WriteString(fmt.Sprintf("当前登录会话: %d\n", len(info.GetSessions())))
+	for _, session := range info.GetSessions() {
+		sb.WriteString("  " + FormatLoginSession(session) + "\n")
+	}
+
+	sb.WriteString(fmt.Sprintf("最近的登录记录: %d\n", len(info.GetHistory())))
+	for _, session := range info.GetHistory() {
+		sb.WriteString("  " + FormatLoginSession(session) + "\n")
+	}
+
+	sb.WriteString(fmt.Sprintf("最近 %v 内成功的SSH登录: %d\n", window, len(info.GetSshLogins())))
+	for _, login := range info.GetSshLogins() {
+		sb.WriteString("  " + FormatSSHLogin(login) + "\n")
+	}
+
+	var failures int32
+	for _, source := range info.GetFailedLogins() {
+		failures += source.GetCount()
+	}
+	sb.WriteString(fmt.Sprintf("最近 %v 内失败的登录: %d 次，来自 %d 个来源\n", window, failures, len(info.GetFailedLogins())))
+	for _, source := range info.GetFailedLogins() {
+		sb.WriteString("  " + FormatFailedLoginSource(source) + "\n")
+	}
+	return sb.String()
+}
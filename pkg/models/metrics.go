@@ -0,0 +1,163 @@
+package models
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"GoMonitor/proto"
+)
+
+// CustomMetricPrefix 是告警规则中引用自定义指标（插件指标等）的前缀，例如 "custom.nginx.active"
+const CustomMetricPrefix = "custom."
+
+// metricExtractors 从SystemInfo中提取可用于告警规则的数值指标
+// 返回false表示本次上报中没有该指标（例如采集器未启用）
+var metricExtractors = map[string]func(*proto.SystemInfo) (float64, bool){
+	"cpu.usage_percent":    cpuMetric(func(c *proto.CPUInfo) float64 { return c.CpuUsagePercent }),
+	"cpu.iowait_percent":   cpuMetric(func(c *proto.CPUInfo) float64 { return c.IowaitPercent }),
+	"cpu.steal_percent":    cpuMetric(func(c *proto.CPUInfo) float64 { return c.StealPercent }),
+	"cpu.load_1m":          cpuMetric(func(c *proto.CPUInfo) float64 { l, _, _ := LoadAverages(c); return l }),
+	"cpu.load_5m":          cpuMetric(func(c *proto.CPUInfo) float64 { _, l, _ := LoadAverages(c); return l }),
+	"cpu.load_15m":         cpuMetric(func(c *proto.CPUInfo) float64 { _, _, l := LoadAverages(c); return l }),
+	"cpu.load_per_core":    cpuMetric(loadPerCore),
+	"memory.usage_percent": memoryMetric(func(m *proto.MemoryInfo) float64 { return m.MemoryUsagePercent }),
+	"memory.available_percent": memoryMetric(func(m *proto.MemoryInfo) float64 {
+		return percentOf(m.AvailableMemory, m.TotalMemory)
+	}),
+	"memory.available_bytes": memoryMetric(func(m *proto.MemoryInfo) float64 { return float64(m.AvailableMemory) }),
+	"memory.dirty_bytes":     memoryMetric(func(m *proto.MemoryInfo) float64 { return float64(m.Dirty) }),
+	"swap.usage_percent": memoryMetric(func(m *proto.MemoryInfo) float64 {
+		return percentOf(m.SwapUsed, m.SwapTotal)
+	}),
+	"disk.max_usage_percent": func(info *proto.SystemInfo) (float64, bool) {
+		partitions := info.GetDiskInfo().GetPartitions()
+		if len(partitions) == 0 {
+			return 0, false
+		}
+		max := 0.0
+		for _, partition := range partitions {
+			if partition.UsagePercent > max {
+				max = partition.UsagePercent
+			}
+		}
+		return max, true
+	},
+	"disk.max_inodes_usage_percent": func(info *proto.SystemInfo) (float64, bool) {
+		max, found := 0.0, false
+		for _, partition := range info.GetDiskInfo().GetPartitions() {
+			if partition.InodesTotal == 0 {
+				continue
+			}
+			found = true
+			if partition.InodesUsagePercent > max {
+				max = partition.InodesUsagePercent
+			}
+		}
+		return max, found
+	},
+	"psi.cpu.some_avg10":    pressureMetric(func(p *proto.PressureInfo) *proto.PressureStall { return p.Cpu }, someAvg10),
+	"psi.cpu.some_avg60":    pressureMetric(func(p *proto.PressureInfo) *proto.PressureStall { return p.Cpu }, someAvg60),
+	"psi.memory.some_avg10": pressureMetric(func(p *proto.PressureInfo) *proto.PressureStall { return p.Memory }, someAvg10),
+	"psi.memory.some_avg60": pressureMetric(func(p *proto.PressureInfo) *proto.PressureStall { return p.Memory }, someAvg60),
+	"psi.memory.full_avg10": pressureMetric(func(p *proto.PressureInfo) *proto.PressureStall { return p.Memory }, fullAvg10),
+	"psi.memory.full_avg60": pressureMetric(func(p *proto.PressureInfo) *proto.PressureStall { return p.Memory }, fullAvg60),
+	"psi.io.some_avg10":     pressureMetric(func(p *proto.PressureInfo) *proto.PressureStall { return p.Io }, someAvg10),
+	"psi.io.some_avg60":     pressureMetric(func(p *proto.PressureInfo) *proto.PressureStall { return p.Io }, someAvg60),
+	"psi.io.full_avg10":     pressureMetric(func(p *proto.PressureInfo) *proto.PressureStall { return p.Io }, fullAvg10),
+	"psi.io.full_avg60":     pressureMetric(func(p *proto.PressureInfo) *proto.PressureStall { return p.Io }, fullAvg60),
+}
+
+func cpuMetric(get func(*proto.CPUInfo) float64) func(*proto.SystemInfo) (float64, bool) {
+	return func(info *proto.SystemInfo) (float64, bool) {
+		if info.GetCpuInfo() == nil {
+			return 0, false
+		}
+		return get(info.CpuInfo), true
+	}
+}
+
+func memoryMetric(get func(*proto.MemoryInfo) float64) func(*proto.SystemInfo) (float64, bool) {
+	return func(info *proto.SystemInfo) (float64, bool) {
+		if info.GetMemoryInfo() == nil {
+			return 0, false
+		}
+		return get(info.MemoryInfo), true
+	}
+}
+
+func pressureMetric(resource func(*proto.PressureInfo) *proto.PressureStall, get func(*proto.PressureStall) float64) func(*proto.SystemInfo) (float64, bool) {
+	return func(info *proto.SystemInfo) (float64, bool) {
+		if info.GetPressureInfo() == nil {
+			return 0, false
+		}
+		stall := resource(info.PressureInfo)
+		if stall == nil {
+			return 0, false
+		}
+		return get(stall), true
+	}
+}
+
+func someAvg10(s *proto.PressureStall) float64 { return s.SomeAvg10 }
+func someAvg60(s *proto.PressureStall) float64 { return s.SomeAvg60 }
+func fullAvg10(s *proto.PressureStall) float64 { return s.FullAvg10 }
+func fullAvg60(s *proto.PressureStall) float64 { return s.FullAvg60 }
+
+// loadPerCore 返回按逻辑核心数归一化的1分钟负载
+func loadPerCore(c *proto.CPUInfo) float64 {
+	load1, _, _ := LoadAverages(c)
+	cores := c.LogicalCores
+	if cores <= 0 {
+		cores = c.CpuCores
+	}
+	if cores <= 0 {
+		return load1
+	}
+	return load1 / float64(cores)
+}
+
+func percentOf(part, total int64) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return float64(part) / float64(total) * 100
+}
+
+// MetricValue 按名称从SystemInfo中取出指标值
+// 以 "custom." 开头的名称引用custom_metrics中可以解析为数字的值
+func MetricValue(info *proto.SystemInfo, name string) (float64, bool) {
+	if strings.HasPrefix(name, CustomMetricPrefix) {
+		raw, ok := info.GetCustomMetrics()[strings.TrimPrefix(name, CustomMetricPrefix)]
+		if !ok {
+			return 0, false
+		}
+		value, err := strconv.ParseFloat(raw, 64)
+		return value, err == nil
+	}
+
+	extract, ok := metricExtractors[name]
+	if !ok {
+		return 0, false
+	}
+	return extract(info)
+}
+
+// IsKnownMetric 判断告警规则中的指标名称是否有效
+func IsKnownMetric(name string) bool {
+	if strings.HasPrefix(name, CustomMetricPrefix) {
+		return len(name) > len(CustomMetricPrefix)
+	}
+	_, ok := metricExtractors[name]
+	return ok
+}
+
+// KnownMetrics 返回所有内置指标名称，按字母顺序排列
+func KnownMetrics() []string {
+	names := make([]string, 0, len(metricExtractors))
+	for name := range metricExtractors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
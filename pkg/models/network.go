@@ -0,0 +1,75 @@
+package models
+
+import (
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"GoMonitor/proto"
+)
+
+// IPAddressesFromNets 将地址列表转换为协议中的表示
+func IPAddressesFromNets(nets []*net.IPNet) []*proto.IPAddress {
+	addresses := make([]*proto.IPAddress, 0, len(nets))
+	for _, ipnet := range nets {
+		prefixLength, _ := ipnet.Mask.Size()
+		addresses = append(addresses, &proto.IPAddress{
+			Address:      ipnet.IP.String(),
+			PrefixLength: int32(prefixLength),
+			Ipv6:         ipnet.IP.To4() == nil,
+			LinkLocal:    ipnet.IP.IsLinkLocalUnicast(),
+		})
+	}
+	return addresses
+}
+
+// FormatIPAddresses 将地址列表格式化为 "192.168.1.10/24, 2001:db8::1/64" 形式
+func FormatIPAddresses(addresses []*proto.IPAddress) string {
+	parts := make([]string, len(addresses))
+	for i, addr := range addresses {
+		parts[i] = addr.Address + "/" + strconv.Itoa(int(addr.PrefixLength))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// DiffIPAddresses 按集合比较两个地址列表，返回新增和移除的地址（"地址/前缀长度" 形式）
+// IPv6地址的不同写法视为相同，列表的顺序不影响比较结果
+func DiffIPAddresses(before, after []*proto.IPAddress) (added, removed []string) {
+	keys := func(addresses []*proto.IPAddress) map[string]bool {
+		set := make(map[string]bool, len(addresses))
+		for _, addr := range addresses {
+			address := addr.Address
+			if ip := net.ParseIP(address); ip != nil {
+				address = ip.String()
+			}
+			set[address+"/"+strconv.Itoa(int(addr.PrefixLength))] = true
+		}
+		return set
+	}
+
+	beforeSet, afterSet := keys(before), keys(after)
+	for key := range afterSet {
+		if !beforeSet[key] {
+			added = append(added, key)
+		}
+	}
+	for key := range beforeSet {
+		if !afterSet[key] {
+			removed = append(removed, key)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	return added, removed
+}
+
+// HasIPAddress 判断地址列表中是否包含ip，IPv6地址的不同写法（例如省略零）视为相同
+func HasIPAddress(addresses []*proto.IPAddress, ip net.IP) bool {
+	for _, addr := range addresses {
+		if ip.Equal(net.ParseIP(addr.Address)) {
+			return true
+		}
+	}
+	return false
+}
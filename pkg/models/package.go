@@ -0,0 +1,57 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strings"
+	"time"
+
+	"GoMonitor/proto"
+)
+
+// PackageChange 是一次软件包安装、卸载或版本变化
+type PackageChange struct {
+	Timestamp  time.Time
+	Manager    string
+	Name       string
+	Arch       string
+	OldVersion string // 新安装时为空
+	NewVersion string // 卸载时为空
+}
+
+// Action 返回变化类型：installed、removed 或 upgraded（版本降低也视为upgraded）
+func (c *PackageChange) Action() string {
+	switch {
+	case c.OldVersion == "":
+		return "installed"
+	case c.NewVersion == "":
+		return "removed"
+	default:
+		return "upgraded"
+	}
+}
+
+// PackageMatch 是跨客户端搜索软件包的一条结果
+type PackageMatch struct {
+	ClientID string
+	Hostname string
+	Package  *proto.Package
+}
+
+// PackageKey 返回软件包的标识，同一管理器下名称和架构相同的包视为同一个包的不同版本
+func PackageKey(pkg *proto.Package) string {
+	return pkg.Manager + "/" + pkg.Name + "/" + pkg.Arch
+}
+
+// PackageDigest 计算软件包列表的摘要，与列表顺序无关
+func PackageDigest(packages map[string]*proto.Package) string {
+	lines := make([]string, 0, len(packages))
+	for key, pkg := range packages {
+		lines = append(lines, key+"="+pkg.Version)
+	}
+	sort.Strings(lines)
+
+	sum := sha256.Sum256([]byte(strings.Join(lines, "\n")))
+	return hex.EncodeToString(sum[:16])
+}
@@ -0,0 +1,98 @@
+package utils
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// RegexPrefix 标记按正则表达式匹配的模式，例如 "re:^veth[0-9a-f]+$"；其他模式按通配符（glob）匹配
+// 通配符中的 "*" 不跨越 "/"，但匹配某个路径的模式同时匹配该路径下的所有路径，例如 "/snap/*" 也匹配 "/snap/core/123"
+const RegexPrefix = "re:"
+
+// Matcher 按包含和排除规则过滤名称
+// 包含规则为空时默认包含所有名称；同时满足包含和排除规则时以排除为准
+type Matcher struct {
+	include []func(string) bool
+	exclude []func(string) bool
+}
+
+// NewMatcher 编译包含和排除规则
+func NewMatcher(include []string, exclude []string) (*Matcher, error) {
+	m := &Matcher{}
+	for _, pattern := range include {
+		match, err := compilePattern(pattern)
+		if err != nil {
+			return nil, err
+		}
+		m.include = append(m.include, match)
+	}
+	for _, pattern := range exclude {
+		match, err := compilePattern(pattern)
+		if err != nil {
+			return nil, err
+		}
+		m.exclude = append(m.exclude, match)
+	}
+	return m, nil
+}
+
+// Match 判断名称是否应被保留，nil表示不过滤
+func (m *Matcher) Match(name string) bool {
+	if m == nil {
+		return true
+	}
+
+	for _, match := range m.exclude {
+		if match(name) {
+			return false
+		}
+	}
+
+	if len(m.include) == 0 {
+		return true
+	}
+	for _, match := range m.include {
+		if match(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidatePattern 检查模式是否为合法的通配符或正则表达式
+func ValidatePattern(pattern string) error {
+	_, err := compilePattern(pattern)
+	return err
+}
+
+// compilePattern 将单个模式编译为匹配函数
+func compilePattern(pattern string) (func(string) bool, error) {
+	if expr, ok := strings.CutPrefix(pattern, RegexPrefix); ok {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("无效的正则表达式 %q: %w", expr, err)
+		}
+		return re.MatchString, nil
+	}
+
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, fmt.Errorf("无效的通配符 %q: %w", pattern, err)
+	}
+	return func(name string) bool {
+		if matched, _ := path.Match(pattern, name); matched {
+			return true
+		}
+		// 依次检查名称中以 "/" 分隔的各级上级路径
+		for i := 1; i < len(name); i++ {
+			if name[i] != '/' {
+				continue
+			}
+			if matched, _ := path.Match(pattern, name[:i]); matched {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
@@ -0,0 +1,48 @@
+package utils
+
+import "testing"
+
+func TestMatcher(t *testing.T) {
+	tests := []struct {
+		name    string
+		include []string
+		exclude []string
+		input   string
+		want    bool
+	}{
+		{"无规则", nil, nil, "eth0", true},
+		{"通配符包含", []string{"eth*"}, nil, "eth0", true},
+		{"通配符不匹配", []string{"eth*"}, nil, "wlan0", false},
+		{"排除优先", []string{"*"}, []string{"veth*"}, "veth1a2b", false},
+		{"正则表达式", []string{"re:^en[op]\\d+"}, nil, "enp3s0", true},
+		{"单字符通配符不匹配名称中的点", nil, []string{"?"}, "eth10", true},
+		{"目录模式匹配子路径", nil, []string{"/snap/*"}, "/snap/core/123", false},
+		{"目录模式匹配自身", nil, []string{"/snap/*"}, "/snap/core", false},
+		{"目录模式不匹配同名前缀", nil, []string{"/snap/*"}, "/snapshots/a", true},
+		{"无通配符的目录", nil, []string{"/var/lib/docker"}, "/var/lib/docker/overlay2/x/merged", false},
+		{"通配符不跨越斜杠", []string{"/var/*/cache"}, nil, "/var/lib/apt/cache", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := NewMatcher(tt.include, tt.exclude)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := m.Match(tt.input); got != tt.want {
+				t.Fatalf("Match(%q) = %v，期望 %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidatePattern(t *testing.T) {
+	for _, pattern := range []string{"[a-", "re:("} {
+		if ValidatePattern(pattern) == nil {
+			t.Fatalf("模式 %q 应无效", pattern)
+		}
+	}
+	if err := ValidatePattern("/snap/*"); err != nil {
+		t.Fatal(err)
+	}
+}
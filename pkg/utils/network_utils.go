@@ -2,25 +2,46 @@ package utils
 
 import (
 	"net"
-	"strings"
 )
 
-// GetLocalIPv4 获取本地IPv4地址
-func GetLocalIPv4() string {
+// GetLocalIPAddresses 获取本机所有非回环地址（IPv4和IPv6），包含前缀长度
+func GetLocalIPAddresses() []*net.IPNet {
 	addrs, err := net.InterfaceAddrs()
 	if err != nil {
-		return "unknown"
+		return nil
 	}
 
+	var result []*net.IPNet
 	for _, addr := range addrs {
 		if ipnet, ok := addr.(*net.IPNet); ok && !ipnet.IP.IsLoopback() {
-			if ipnet.IP.To4() != nil {
-				return ipnet.IP.String()
+			result = append(result, ipnet)
+		}
+	}
+	return result
+}
+
+// PrimaryIPAddress 从地址列表中选出用于展示的主地址
+// 优先选择IPv4地址，其次全局IPv6地址，最后是链路本地地址；列表为空时返回空字符串
+func PrimaryIPAddress(addrs []*net.IPNet) string {
+	var globalV6, linkLocal string
+	for _, ipnet := range addrs {
+		ip := ipnet.IP
+		switch {
+		case ip.IsLinkLocalUnicast():
+			if linkLocal == "" {
+				linkLocal = ip.String()
 			}
+		case ip.To4() != nil:
+			return ip.String()
+		case globalV6 == "":
+			globalV6 = ip.String()
 		}
 	}
 
-	return "unknown"
+	if globalV6 != "" {
+		return globalV6
+	}
+	return linkLocal
 }
 
 // GetLocalMACAddress 获取本地MAC地址
@@ -41,36 +62,15 @@ func GetLocalMACAddress() string {
 	return "unknown"
 }
 
-// GetInterfaceIPAddress 获取指定网络接口的IP地址
-func GetInterfaceIPAddress(iface net.Interface) string {
-	addrs, err := iface.Addrs()
-	if err != nil {
-		return ""
-	}
-
-	for _, addr := range addrs {
-		if ipnet, ok := addr.(*net.IPNet); ok {
-			if ipnet.IP.To4() != nil {
-				return ipnet.IP.String()
-			}
-		}
-	}
-
-	return ""
-}
-
-// ShouldIncludeInterface 判断是否应包含某网络接口
-func ShouldIncludeInterface(name string) bool {
-	// 过滤掉一些虚拟接口
-	excludePrefixes := []string{
-		"lo", "veth", "docker", "br-", "vmnet", "vbox", "virbr",
-	}
-
-	for _, prefix := range excludePrefixes {
-		if strings.HasPrefix(name, prefix) {
-			return false
+// ParseInterfaceAddresses 解析 "192.168.1.10/24" 形式的地址列表，保留主机地址而非网络地址，无法解析的项被忽略
+func ParseInterfaceAddresses(cidrs []string) []*net.IPNet {
+	var result []*net.IPNet
+	for _, cidr := range cidrs {
+		ip, ipnet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			continue
 		}
+		result = append(result, &net.IPNet{IP: ip, Mask: ipnet.Mask})
 	}
-
-	return true
+	return result
 }
@@ -48,3 +48,8 @@ func FormatBytes(bytes int64) string {
 		return fmt.Sprintf("%d B", bytes)
 	}
 }
+
+// FormatRate 格式化每秒字节数，例如 "1.50 MB/s"
+func FormatRate(bytesPerSec float64) string {
+	return FormatBytes(int64(bytesPerSec)) + "/s"
+}
@@ -0,0 +1,403 @@
+package utils
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// CompareDebianVersions 按dpkg的规则比较两个版本号 [epoch:]upstream[-revision]
+// a小于、等于、大于b时分别返回负数、0、正数
+func CompareDebianVersions(a, b string) int {
+	epochA, upstreamA, revisionA := splitDebianVersion(a)
+	epochB, upstreamB, revisionB := splitDebianVersion(b)
+
+	if epochA != epochB {
+		return compareInts(epochA, epochB)
+	}
+	if c := compareDebianPart(upstreamA, upstreamB); c != 0 {
+		return c
+	}
+	return compareDebianPart(revisionA, revisionB)
+}
+
+// splitDebianVersion 拆分版本号，revision为最后一个"-"之后的部分
+func splitDebianVersion(v string) (int, string, string) {
+	epoch := 0
+	if before, after, found := strings.Cut(v, ":"); found {
+		epoch, _ = strconv.Atoi(before)
+		v = after
+	}
+	if idx := strings.LastIndex(v, "-"); idx >= 0 {
+		return epoch, v[:idx], v[idx+1:]
+	}
+	return epoch, v, ""
+}
+
+// debianCharOrder 返回字符在非数字部分中的排序权重：字母最小，"~"比任何字符（包括结尾）都小
+func debianCharOrder(s string, i int) int {
+	if i >= len(s) {
+		return 0
+	}
+	c := s[i]
+	switch {
+	case c >= '0' && c <= '9':
+		return 0
+	case (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z'):
+		return int(c)
+	case c == '~':
+		return -1
+	default:
+		return int(c) + 256
+	}
+}
+
+// compareDebianPart 实现dpkg的verrevcmp：交替比较非数字部分和数字部分
+func compareDebianPart(a, b string) int {
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		for (i < len(a) && !isDigit(a[i])) || (j < len(b) && !isDigit(b[j])) {
+			ac, bc := debianCharOrder(a, i), debianCharOrder(b, j)
+			if ac != bc {
+				return ac - bc
+			}
+			i++
+			j++
+		}
+
+		for i < len(a) && a[i] == '0' {
+			i++
+		}
+		for j < len(b) && b[j] == '0' {
+			j++
+		}
+
+		firstDiff := 0
+		for i < len(a) && isDigit(a[i]) && j < len(b) && isDigit(b[j]) {
+			if firstDiff == 0 {
+				firstDiff = int(a[i]) - int(b[j])
+			}
+			i++
+			j++
+		}
+		if i < len(a) && isDigit(a[i]) {
+			return 1
+		}
+		if j < len(b) && isDigit(b[j]) {
+			return -1
+		}
+		if firstDiff != 0 {
+			return firstDiff
+		}
+	}
+	return 0
+}
+
+// CompareRPMVersions 按rpm的规则比较两个版本号 [epoch:]version[-release]，任一方缺少release时不比较release
+func CompareRPMVersions(a, b string) int {
+	epochA, versionA, releaseA := splitRPMVersion(a)
+	epochB, versionB, releaseB := splitRPMVersion(b)
+
+	if epochA != epochB {
+		return compareInts(epochA, epochB)
+	}
+	if c := rpmvercmp(versionA, versionB); c != 0 {
+		return c
+	}
+	if releaseA == "" || releaseB == "" {
+		return 0
+	}
+	return rpmvercmp(releaseA, releaseB)
+}
+
+// splitRPMVersion 拆分版本号，release为最后一个"-"之后的部分
+func splitRPMVersion(v string) (int, string, string) {
+	epoch := 0
+	if before, after, found := strings.Cut(v, ":"); found {
+		epoch, _ = strconv.Atoi(before)
+		v = after
+	}
+	if idx := strings.LastIndex(v, "-"); idx >= 0 {
+		return epoch, v[:idx], v[idx+1:]
+	}
+	return epoch, v, ""
+}
+
+// rpmvercmp 实现rpm的同名函数：按字母段和数字段逐段比较，数字段总是比字母段新，"~"比任何内容都旧，"^"比结尾新
+func rpmvercmp(a, b string) int {
+	if a == b {
+		return 0
+	}
+
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		for i < len(a) && !isAlnum(a[i]) && a[i] != '~' && a[i] != '^' {
+			i++
+		}
+		for j < len(b) && !isAlnum(b[j]) && b[j] != '~' && b[j] != '^' {
+			j++
+		}
+
+		aTilde, bTilde := i < len(a) && a[i] == '~', j < len(b) && b[j] == '~'
+		if aTilde || bTilde {
+			if !aTilde {
+				return 1
+			}
+			if !bTilde {
+				return -1
+			}
+			i++
+			j++
+			continue
+		}
+
+		aCaret, bCaret := i < len(a) && a[i] == '^', j < len(b) && b[j] == '^'
+		if aCaret || bCaret {
+			if i >= len(a) {
+				return -1
+			}
+			if j >= len(b) {
+				return 1
+			}
+			if !aCaret {
+				return 1
+			}
+			if !bCaret {
+				return -1
+			}
+			i++
+			j++
+			continue
+		}
+
+		if i >= len(a) || j >= len(b) {
+			break
+		}
+
+		numeric := isDigit(a[i])
+		segA := takeSegment(a, &i, numeric)
+		segB := takeSegment(b, &j, numeric)
+		if segB == "" {
+			// 两段类型不同，数字段更新
+			if numeric {
+				return 1
+			}
+			return -1
+		}
+
+		if numeric {
+			segA = strings.TrimLeft(segA, "0")
+			segB = strings.TrimLeft(segB, "0")
+			if len(segA) != len(segB) {
+				return compareInts(len(segA), len(segB))
+			}
+		}
+		if c := strings.Compare(segA, segB); c != 0 {
+			return c
+		}
+	}
+
+	switch {
+	case i >= len(a) && j >= len(b):
+		return 0
+	case i >= len(a):
+		return -1
+	default:
+		return 1
+	}
+}
+
+// takeSegment 从s[*i]开始取出连续的数字或字母
+func takeSegment(s string, i *int, numeric bool) string {
+	start := *i
+	for *i < len(s) {
+		if numeric && !isDigit(s[*i]) || !numeric && !isAlpha(s[*i]) {
+			break
+		}
+		*i++
+	}
+	return s[start:*i]
+}
+
+// CompareSemver 按语义化版本规则比较 major.minor.patch[-prerelease][+build]，缺少的部分视为0
+// 带预发布标识的版本比对应的正式版本旧，构建元数据不参与比较
+func CompareSemver(a, b string) int {
+	coreA, preA := splitSemver(a)
+	coreB, preB := splitSemver(b)
+
+	for k := 0; k < 3; k++ {
+		if coreA[k] != coreB[k] {
+			return compareInts(coreA[k], coreB[k])
+		}
+	}
+
+	switch {
+	case preA == "" && preB == "":
+		return 0
+	case preA == "":
+		return 1
+	case preB == "":
+		return -1
+	}
+
+	idsA, idsB := strings.Split(preA, "."), strings.Split(preB, ".")
+	for k := 0; k < len(idsA) && k < len(idsB); k++ {
+		numA, errA := strconv.Atoi(idsA[k])
+		numB, errB := strconv.Atoi(idsB[k])
+		switch {
+		case errA == nil && errB == nil:
+			if numA != numB {
+				return compareInts(numA, numB)
+			}
+		case errA == nil:
+			return -1
+		case errB == nil:
+			return 1
+		default:
+			if c := strings.Compare(idsA[k], idsB[k]); c != 0 {
+				return c
+			}
+		}
+	}
+	return compareInts(len(idsA), len(idsB))
+}
+
+// splitSemver 返回版本的三个数字部分和预发布标识
+func splitSemver(v string) ([3]int, string) {
+	v = strings.TrimPrefix(strings.TrimSpace(v), "v")
+	v, _, _ = strings.Cut(v, "+")
+	v, pre, _ := strings.Cut(v, "-")
+
+	var core [3]int
+	for k, part := range strings.SplitN(v, ".", 3) {
+		core[k], _ = strconv.Atoi(part)
+	}
+	return core, pre
+}
+
+// pep440Pattern 匹配PEP 440版本号：[N!]N(.N)*[{a|b|rc}N][.postN][.devN][+local]
+var pep440Pattern = regexp.MustCompile(`^(?:(\d+)!)?(\d+(?:\.\d+)*)` +
+	`(?:[-_.]?(a|alpha|b|beta|c|rc|pre|preview)[-_.]?(\d*))?` +
+	`(?:-(\d+)|[-_.]?(?:post|rev|r)[-_.]?(\d*))?` +
+	`(?:[-_.]?dev[-_.]?(\d*))?` +
+	`(?:\+[a-z0-9._]+)?$`)
+
+// pep440Version 是解析后可直接逐项比较的PEP 440版本
+type pep440Version struct {
+	epoch   int
+	release []int
+	pre     [2]int // 预发布阶段（a=0、b=1、rc=2，正式版为3，只有dev时为-1）和序号
+	post    int    // 没有post时为-1
+	dev     int    // 没有dev时为最大值
+}
+
+// ComparePythonVersions 按PEP 440比较Python包版本，无法解析时按字符串比较
+func ComparePythonVersions(a, b string) int {
+	va, okA := parsePEP440(a)
+	vb, okB := parsePEP440(b)
+	if !okA || !okB {
+		return strings.Compare(a, b)
+	}
+
+	if va.epoch != vb.epoch {
+		return compareInts(va.epoch, vb.epoch)
+	}
+	for k := 0; k < len(va.release) || k < len(vb.release); k++ {
+		var x, y int
+		if k < len(va.release) {
+			x = va.release[k]
+		}
+		if k < len(vb.release) {
+			y = vb.release[k]
+		}
+		if x != y {
+			return compareInts(x, y)
+		}
+	}
+	for k := 0; k < 2; k++ {
+		if va.pre[k] != vb.pre[k] {
+			return compareInts(va.pre[k], vb.pre[k])
+		}
+	}
+	if va.post != vb.post {
+		return compareInts(va.post, vb.post)
+	}
+	return compareInts(va.dev, vb.dev)
+}
+
+// parsePEP440 解析PEP 440版本号
+func parsePEP440(s string) (pep440Version, bool) {
+	s = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(s)), "v")
+	idx := pep440Pattern.FindStringSubmatchIndex(s)
+	if idx == nil {
+		return pep440Version{}, false
+	}
+	// group 返回第n个分组的内容，分组未参与匹配时matched为false（与匹配到空字符串区分）
+	group := func(n int) (value string, matched bool) {
+		if idx[2*n] < 0 {
+			return "", false
+		}
+		return s[idx[2*n]:idx[2*n+1]], true
+	}
+
+	v := pep440Version{post: -1, dev: int(^uint(0) >> 1)}
+	epoch, _ := group(1)
+	v.epoch, _ = strconv.Atoi(epoch)
+	release, _ := group(2)
+	for _, part := range strings.Split(release, ".") {
+		n, _ := strconv.Atoi(part)
+		v.release = append(v.release, n)
+	}
+
+	phase, hasPre := group(3)
+	switch phase {
+	case "":
+		v.pre[0] = 3
+	case "a", "alpha":
+		v.pre[0] = 0
+	case "b", "beta":
+		v.pre[0] = 1
+	default:
+		v.pre[0] = 2
+	}
+	preNumber, _ := group(4)
+	v.pre[1], _ = strconv.Atoi(preNumber)
+
+	if implicitPost, ok := group(5); ok {
+		v.post, _ = strconv.Atoi(implicitPost)
+	} else if post, ok := group(6); ok {
+		v.post, _ = strconv.Atoi(post)
+	}
+
+	if dev, ok := group(7); ok {
+		v.dev, _ = strconv.Atoi(dev)
+		// 只有dev的版本（例如1.0.dev1）比该版本的所有预发布版本都旧
+		if !hasPre && v.post < 0 {
+			v.pre[0] = -1
+		}
+	}
+	return v, true
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isAlpha(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isAlnum(c byte) bool {
+	return isDigit(c) || isAlpha(c)
+}
+
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
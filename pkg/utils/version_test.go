@@ -0,0 +1,189 @@
+package utils
+
+import "testing"
+
+// sign 把比较结果归一为-1、0、1
+func sign(c int) int {
+	return compareInts(c, 0)
+}
+
+// checkOrder 检查versions按从旧到新排列，任意两个版本的比较结果的符号都与顺序一致
+func checkOrder(t *testing.T, compare func(a, b string) int, versions []string) {
+	t.Helper()
+	for i := range versions {
+		for j := range versions {
+			want := compareInts(i, j)
+			if got := sign(compare(versions[i], versions[j])); got != want {
+				t.Errorf("compare(%q, %q) = %d, 期望 %d", versions[i], versions[j], got, want)
+			}
+		}
+	}
+}
+
+// checkEqual 检查每对版本相等
+func checkEqual(t *testing.T, compare func(a, b string) int, pairs [][2]string) {
+	t.Helper()
+	for _, pair := range pairs {
+		if got := compare(pair[0], pair[1]); got != 0 {
+			t.Errorf("compare(%q, %q) = %d, 期望 0", pair[0], pair[1], got)
+		}
+	}
+}
+
+func TestCompareDebianVersions(t *testing.T) {
+	tests := []struct {
+		name     string
+		versions []string
+	}{
+		{"波浪号早于一切", []string{"1.0~~", "1.0~~a", "1.0~", "1.0~rc1", "1.0", "1.0a", "1.0+b1", "1.0.1"}},
+		{"数字按数值比较", []string{"1.2", "1.9", "1.10", "1.100"}},
+		{"修订号", []string{"1.0-1", "1.0-1ubuntu1", "1.0-2", "1.0-10"}},
+		{"纪元优先", []string{"9.9-9", "1:0.1-1", "1:0.2", "2:0.0.1"}},
+		{"发行版后缀", []string{"3.0.11-1~deb12u1", "3.0.11-1~deb12u2", "3.0.11-1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkOrder(t, CompareDebianVersions, tt.versions)
+		})
+	}
+
+	checkEqual(t, CompareDebianVersions, [][2]string{
+		{"1.0", "0:1.0"},
+		{"1.0-1", "0:1.0-1"},
+		{"1.01", "1.1"},
+	})
+}
+
+func TestCompareRPMVersions(t *testing.T) {
+	tests := []struct {
+		name     string
+		versions []string
+	}{
+		{"波浪号早于正式版", []string{"1.0~rc1", "1.0~rc2", "1.0"}},
+		{"脱字符晚于正式版、早于下一版本", []string{"1.0~rc1", "1.0", "1.0^git1", "1.0^git2", "1.0.1"}},
+		{"数字段比字母段新", []string{"1.0.a", "1.0.1"}},
+		{"多出的段更新", []string{"1.0", "1.0a", "1.0.0"}},
+		{"数字按数值比较", []string{"1.9", "1.10", "1.010.1"}},
+		{"发布号", []string{"1.0-1.el9", "1.0-2.el9", "1.0-10.el9"}},
+		{"纪元优先", []string{"9.9-9", "1:0.1-1", "2:0.0.1-1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkOrder(t, CompareRPMVersions, tt.versions)
+		})
+	}
+
+	checkEqual(t, CompareRPMVersions, [][2]string{
+		{"1.0", "0:1.0"},
+		{"1.0_1", "1.0.1"},
+		{"1.01", "1.1"},
+	})
+}
+
+func TestCompareSemver(t *testing.T) {
+	checkOrder(t, CompareSemver, []string{
+		"0.9.9",
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0",
+		"1.0.1",
+		"1.10.0",
+		"2.0.0",
+	})
+
+	checkEqual(t, CompareSemver, [][2]string{
+		{"1.0.0+build.1", "1.0.0"},
+		{"v1.2.3", "1.2.3"},
+		{"1.2", "1.2.0"},
+	})
+}
+
+func TestComparePythonVersions(t *testing.T) {
+	checkOrder(t, ComparePythonVersions, []string{
+		"1.0.dev1",
+		"1.0a1",
+		"1.0a2.dev1",
+		"1.0a2",
+		"1.0b1",
+		"1.0rc1",
+		"1.0",
+		"1.0.post1.dev1",
+		"1.0.post1",
+		"1.1.dev1",
+		"1.1",
+		"2.0",
+		"1!0.5",
+	})
+
+	checkEqual(t, ComparePythonVersions, [][2]string{
+		{"1.0", "1.0.0"},
+		{"1.0-1", "1.0.post1"},
+		{"1.0.alpha1", "1.0a1"},
+		{"1.0c1", "1.0rc1"},
+		{"1.0+local.1", "1.0"},
+		{"V1.0", "1.0"},
+	})
+}
+
+func TestParsePEP440(t *testing.T) {
+	tests := []struct {
+		input string
+		want  pep440Version
+		ok    bool
+	}{
+		{"1.0", pep440Version{release: []int{1, 0}, pre: [2]int{3, 0}, post: -1, dev: int(^uint(0) >> 1)}, true},
+		{"2!1.0rc2.post3.dev4", pep440Version{epoch: 2, release: []int{1, 0}, pre: [2]int{2, 2}, post: 3, dev: 4}, true},
+		{"1.0.dev0", pep440Version{release: []int{1, 0}, pre: [2]int{-1, 0}, post: -1, dev: 0}, true},
+		{"1.0.post", pep440Version{release: []int{1, 0}, pre: [2]int{3, 0}, post: 0, dev: int(^uint(0) >> 1)}, true},
+		{"not-a-version", pep440Version{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, ok := parsePEP440(tt.input)
+			if ok != tt.ok {
+				t.Fatalf("ok = %v, 期望 %v", ok, tt.ok)
+			}
+			if !ok {
+				return
+			}
+			if got.epoch != tt.want.epoch || got.pre != tt.want.pre || got.post != tt.want.post || got.dev != tt.want.dev ||
+				len(got.release) != len(tt.want.release) {
+				t.Fatalf("parsePEP440(%q) = %+v, 期望 %+v", tt.input, got, tt.want)
+			}
+			for i := range got.release {
+				if got.release[i] != tt.want.release[i] {
+					t.Fatalf("parsePEP440(%q) = %+v, 期望 %+v", tt.input, got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestRPMVerCmp(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.0", "1.0", 0},
+		{"1.0", "2.0", -1},
+		{"2.0.1", "2.0", 1},
+		{"2.0", "2_0", 0},
+		{"1.0~rc1", "1.0", -1},
+		{"1.0^", "1.0", 1},
+		{"1.0^", "1.0~", 1},
+		{"a", "1", -1},
+		{"fc4", "fc.4", 0},
+	}
+
+	for _, tt := range tests {
+		if got := sign(rpmvercmp(tt.a, tt.b)); got != tt.want {
+			t.Errorf("rpmvercmp(%q, %q) = %d, 期望 %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
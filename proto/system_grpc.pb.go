@@ -23,6 +23,8 @@ const (
 	SystemInfoService_SendSystemInfo_FullMethodName      = "/system.SystemInfoService/SendSystemInfo"
 	SystemInfoService_ReceiveCommands_FullMethodName     = "/system.SystemInfoService/ReceiveCommands"
 	SystemInfoService_ReportCommandResult_FullMethodName = "/system.SystemInfoService/ReportCommandResult"
+	SystemInfoService_AckConfig_FullMethodName           = "/system.SystemInfoService/AckConfig"
+	SystemInfoService_SendLogs_FullMethodName            = "/system.SystemInfoService/SendLogs"
 )
 
 // SystemInfoServiceClient is the client API for SystemInfoService service.
@@ -39,6 +41,10 @@ type SystemInfoServiceClient interface {
 	ReceiveCommands(ctx context.Context, in *CommandRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Command], error)
 	// 客户端向服务端报告命令执行结果
 	ReportCommandResult(ctx context.Context, in *CommandResult, opts ...grpc.CallOption) (*CommandResultResponse, error)
+	// 客户端确认已应用服务端下发的配置
+	AckConfig(ctx context.Context, in *ConfigAck, opts ...grpc.CallOption) (*ConfigAckResponse, error)
+	// 客户端转发日志行
+	SendLogs(ctx context.Context, in *LogBatch, opts ...grpc.CallOption) (*LogBatchResponse, error)
 }
 
 type systemInfoServiceClient struct {
@@ -98,6 +104,26 @@ func (c *systemInfoServiceClient) ReportCommandResult(ctx context.Context, in *C
 	return out, nil
 }
 
+func (c *systemInfoServiceClient) AckConfig(ctx context.Context, in *ConfigAck, opts ...grpc.CallOption) (*ConfigAckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfigAckResponse)
+	err := c.cc.Invoke(ctx, SystemInfoService_AckConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemInfoServiceClient) SendLogs(ctx context.Context, in *LogBatch, opts ...grpc.CallOption) (*LogBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LogBatchResponse)
+	err := c.cc.Invoke(ctx, SystemInfoService_SendLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SystemInfoServiceServer is the server API for SystemInfoService service.
 // All implementations must embed UnimplementedSystemInfoServiceServer
 // for forward compatibility.
@@ -112,6 +138,10 @@ type SystemInfoServiceServer interface {
 	ReceiveCommands(*CommandRequest, grpc.ServerStreamingServer[Command]) error
 	// 客户端向服务端报告命令执行结果
 	ReportCommandResult(context.Context, *CommandResult) (*CommandResultResponse, error)
+	// 客户端确认已应用服务端下发的配置
+	AckConfig(context.Context, *ConfigAck) (*ConfigAckResponse, error)
+	// 客户端转发日志行
+	SendLogs(context.Context, *LogBatch) (*LogBatchResponse, error)
 	mustEmbedUnimplementedSystemInfoServiceServer()
 }
 
@@ -134,6 +164,12 @@ func (UnimplementedSystemInfoServiceServer) ReceiveCommands(*CommandRequest, grp
 func (UnimplementedSystemInfoServiceServer) ReportCommandResult(context.Context, *CommandResult) (*CommandResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReportCommandResult not implemented")
 }
+func (UnimplementedSystemInfoServiceServer) AckConfig(context.Context, *ConfigAck) (*ConfigAckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AckConfig not implemented")
+}
+func (UnimplementedSystemInfoServiceServer) SendLogs(context.Context, *LogBatch) (*LogBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendLogs not implemented")
+}
 func (UnimplementedSystemInfoServiceServer) mustEmbedUnimplementedSystemInfoServiceServer() {}
 func (UnimplementedSystemInfoServiceServer) testEmbeddedByValue()                           {}
 
@@ -220,6 +256,42 @@ func _SystemInfoService_ReportCommandResult_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SystemInfoService_AckConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigAck)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemInfoServiceServer).AckConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SystemInfoService_AckConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemInfoServiceServer).AckConfig(ctx, req.(*ConfigAck))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemInfoService_SendLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemInfoServiceServer).SendLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SystemInfoService_SendLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemInfoServiceServer).SendLogs(ctx, req.(*LogBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SystemInfoService_ServiceDesc is the grpc.ServiceDesc for SystemInfoService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -239,6 +311,14 @@ var SystemInfoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReportCommandResult",
 			Handler:    _SystemInfoService_ReportCommandResult_Handler,
 		},
+		{
+			MethodName: "AckConfig",
+			Handler:    _SystemInfoService_AckConfig_Handler,
+		},
+		{
+			MethodName: "SendLogs",
+			Handler:    _SystemInfoService_SendLogs_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
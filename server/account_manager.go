@@ -0,0 +1,118 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"GoMonitor/pkg/models"
+	"GoMonitor/proto"
+)
+
+// AccountManager 保存各客户端最近一次上报的本地账户清单，并记录账户的增加、删除和变化
+type AccountManager struct {
+	server    *Server
+	inventory map[string]*proto.AccountInventory // client_id -> 最近一次的账户清单
+}
+
+// NewAccountManager 创建账户管理器
+func NewAccountManager(server *Server) *AccountManager {
+	return &AccountManager{
+		server:    server,
+		inventory: make(map[string]*proto.AccountInventory),
+	}
+}
+
+// Update 处理客户端上报的账户清单，本次未上报时保持原状态
+// 首次收到某个客户端的清单时只作为基线，不产生事件
+func (am *AccountManager) Update(clientID string, inventory *proto.AccountInventory) {
+	if inventory == nil {
+		return
+	}
+
+	previous, known := am.inventory[clientID]
+	am.inventory[clientID] = inventory
+	if !known {
+		return
+	}
+
+	before := accountsByName(previous)
+	after := accountsByName(inventory)
+	for _, account := range inventory.GetAccounts() {
+		old, ok := before[account.GetName()]
+		if !ok {
+			severity := models.SeverityInfo
+			message := fmt.Sprintf("新增账户 %s (uid=%d, shell=%s)", account.GetName(), account.GetUid(), account.GetShell())
+			if account.GetRoot() {
+				severity = models.SeverityWarning
+				message += "，拥有root权限: " + models.RootReason(account)
+			}
+			am.server.eventManager.Record(clientID, "account_added", severity, message)
+			continue
+		}
+
+		diffs := models.DiffAccount(old, account)
+		if len(diffs) == 0 {
+			continue
+		}
+		severity := models.SeverityInfo
+		if account.GetRoot() && !old.GetRoot() {
+			severity = models.SeverityWarning
+		}
+		am.server.eventManager.Record(clientID, "account_changed", severity,
+			fmt.Sprintf("账户 %s 已修改: %s", account.GetName(), strings.Join(diffs, ", ")))
+	}
+
+	for _, account := range previous.GetAccounts() {
+		if _, ok := after[account.GetName()]; !ok {
+			am.server.eventManager.Record(clientID, "account_removed", models.SeverityInfo,
+				fmt.Sprintf("账户 %s 已删除 (uid=%d)", account.GetName(), account.GetUid()))
+		}
+	}
+}
+
+// accountsByName 按账户名索引清单中的账户
+func accountsByName(inventory *proto.AccountInventory) map[string]*proto.LocalAccount {
+	accounts := make(map[string]*proto.LocalAccount, len(inventory.GetAccounts()))
+	for _, account := range inventory.GetAccounts() {
+		accounts[account.GetName()] = account
+	}
+	return accounts
+}
+
+// GetAccounts 返回客户端最近一次上报的账户清单，尚未收到时返回nil
+func (am *AccountManager) GetAccounts(clientID string) *proto.AccountInventory {
+	return am.inventory[clientID]
+}
+
+// RootAccess 列出所有客户端上拥有root权限的账户，按主机名和账户名排序
+func (am *AccountManager) RootAccess() []*models.RootAccess {
+	var result []*models.RootAccess
+	for clientID, inventory := range am.inventory {
+		hostname := ""
+		if client, ok := am.server.clients[clientID]; ok {
+			hostname = client.Hostname
+		}
+		for _, account := range inventory.GetAccounts() {
+			if account.GetRoot() {
+				result = append(result, &models.RootAccess{ClientID: clientID, Hostname: hostname, Account: account})
+			}
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Hostname != result[j].Hostname {
+			return result[i].Hostname < result[j].Hostname
+		}
+		if result[i].ClientID != result[j].ClientID {
+			return result[i].ClientID < result[j].ClientID
+		}
+		return result[i].Account.GetName() < result[j].Account.GetName()
+	})
+	return result
+}
+
+// RemoveClient 清除客户端的账户清单
+func (am *AccountManager) RemoveClient(clientID string) {
+	delete(am.inventory, clientID)
+}
@@ -0,0 +1,439 @@
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"GoMonitor/pkg/models"
+	"GoMonitor/pkg/utils"
+	"GoMonitor/proto"
+)
+
+// ecosystemManagers 是支持匹配的OSV生态系统及其对应的包管理器
+var ecosystemManagers = map[string]string{
+	"Debian":      "dpkg",
+	"Ubuntu":      "dpkg",
+	"Red Hat":     "rpm",
+	"AlmaLinux":   "rpm",
+	"Rocky Linux": "rpm",
+	"SUSE":        "rpm",
+	"openSUSE":    "rpm",
+	"openEuler":   "rpm",
+	"Mageia":      "rpm",
+	"PyPI":        "pip",
+	"npm":         "npm",
+}
+
+// distroEcosystems 是客户端清单中的发行版名称对应的OSV生态系统
+var distroEcosystems = map[string]string{
+	"debian":              "Debian",
+	"ubuntu":              "Ubuntu",
+	"redhat":              "Red Hat",
+	"almalinux":           "AlmaLinux",
+	"rocky":               "Rocky Linux",
+	"sles":                "SUSE",
+	"opensuse-leap":       "openSUSE",
+	"opensuse-tumbleweed": "openSUSE",
+	"openeuler":           "openEuler",
+	"mageia":              "Mageia",
+}
+
+// managerVersionCompare 是各包管理器的版本比较函数
+var managerVersionCompare = map[string]func(a, b string) int{
+	"dpkg": utils.CompareDebianVersions,
+	"rpm":  utils.CompareRPMVersions,
+	"pip":  utils.ComparePythonVersions,
+	"npm":  utils.CompareSemver,
+}
+
+// advisoryEntry 是索引中的一条记录：一条公告影响的一个软件包
+type advisoryEntry struct {
+	advisory  *models.Advisory
+	severity  string
+	affected  *models.AffectedPackage
+	ecosystem string
+	release   string
+}
+
+// advisoryIndex 按 "包管理器/包名" 索引公告
+type advisoryIndex struct {
+	entries map[string][]*advisoryEntry
+	count   int // 公告数量
+}
+
+// newAdvisoryIndex 创建空的公告索引
+func newAdvisoryIndex() *advisoryIndex {
+	return &advisoryIndex{entries: make(map[string][]*advisoryEntry)}
+}
+
+// AdvisoryManager 用离线公告匹配各客户端已安装的软件包，客户端新命中达到告警级别的公告时触发告警
+type AdvisoryManager struct {
+	server    *Server
+	index     *advisoryIndex
+	findings  map[string][]*models.VulnerabilityFinding // client_id -> 命中的公告
+	evaluated map[string]string                         // client_id -> 上次匹配时的软件包摘要和发行版
+}
+
+// NewAdvisoryManager 创建公告管理器
+func NewAdvisoryManager(server *Server) *AdvisoryManager {
+	return &AdvisoryManager{
+		server:    server,
+		index:     newAdvisoryIndex(),
+		findings:  make(map[string][]*models.VulnerabilityFinding),
+		evaluated: make(map[string]string),
+	}
+}
+
+// advisoryAlertKey 返回公告对应的告警标识
+func advisoryAlertKey(id string) string {
+	return "advisory:" + id
+}
+
+// packageIndexKey 返回软件包在索引中的标识，PyPI包名按PEP 503规范化
+func packageIndexKey(manager string, name string) string {
+	name = strings.ToLower(name)
+	if manager == "pip" {
+		name = strings.NewReplacer("_", "-", ".", "-").Replace(name)
+	}
+	return manager + "/" + name
+}
+
+// loadAdvisories 读取所有公告文件并建立索引，目录会被递归遍历
+// 不支持的生态系统和已撤回的公告会被跳过；任一文件无法解析时返回错误
+func loadAdvisories(paths []string) (*advisoryIndex, error) {
+	index := newAdvisoryIndex()
+
+	add := func(advisories []*models.Advisory) {
+		for _, advisory := range advisories {
+			if advisory.Withdrawn != "" {
+				continue
+			}
+			severity := advisory.NormalizedSeverity()
+			indexed := false
+			for i := range advisory.Affected {
+				affected := &advisory.Affected[i]
+				ecosystem, release := affected.Ecosystem()
+				manager, ok := ecosystemManagers[ecosystem]
+				if !ok {
+					continue
+				}
+				key := packageIndexKey(manager, affected.Package.Name)
+				index.entries[key] = append(index.entries[key], &advisoryEntry{
+					advisory:  advisory,
+					severity:  severity,
+					affected:  affected,
+					ecosystem: ecosystem,
+					release:   release,
+				})
+				indexed = true
+			}
+			if indexed {
+				index.count++
+			}
+		}
+	}
+
+	for _, root := range paths {
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				return nil
+			}
+
+			var advisories []*models.Advisory
+			switch strings.ToLower(filepath.Ext(path)) {
+			case ".json":
+				advisories, err = readAdvisoryFile(path)
+			case ".zip":
+				advisories, err = readAdvisoryZip(path)
+			default:
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+			add(advisories)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return index, nil
+}
+
+// readAdvisoryFile 读取一个JSON文件，内容可以是单条公告或公告数组
+func readAdvisoryFile(path string) ([]*models.Advisory, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return parseAdvisories(data)
+}
+
+// readAdvisoryZip 读取OSV导出的zip文件（例如 Debian/all.zip），其中每个JSON文件为一条公告
+func readAdvisoryZip(path string) ([]*models.Advisory, error) {
+	archive, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, err
+	}
+	defer archive.Close()
+
+	var advisories []*models.Advisory
+	for _, file := range archive.File {
+		if !strings.HasSuffix(strings.ToLower(file.Name), ".json") {
+			continue
+		}
+		rc, err := file.Open()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file.Name, err)
+		}
+		data, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file.Name, err)
+		}
+
+		parsed, err := parseAdvisories(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file.Name, err)
+		}
+		advisories = append(advisories, parsed...)
+	}
+	return advisories, nil
+}
+
+// parseAdvisories 解析单条公告或公告数组
+func parseAdvisories(data []byte) ([]*models.Advisory, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '[' {
+		var advisories []*models.Advisory
+		if err := json.Unmarshal(data, &advisories); err != nil {
+			return nil, err
+		}
+		return advisories, nil
+	}
+
+	var advisory models.Advisory
+	if err := json.Unmarshal(data, &advisory); err != nil {
+		return nil, err
+	}
+	return []*models.Advisory{&advisory}, nil
+}
+
+// SetIndex 替换公告索引并重新匹配所有客户端
+func (am *AdvisoryManager) SetIndex(index *advisoryIndex) {
+	am.index = index
+	am.evaluated = make(map[string]string)
+	am.EvaluateAll()
+}
+
+// EvaluateAll 重新匹配所有客户端，用于公告或告警级别变化后
+func (am *AdvisoryManager) EvaluateAll() {
+	for clientID := range am.server.pkgManager.packages {
+		delete(am.evaluated, clientID)
+		am.Evaluate(clientID)
+	}
+}
+
+// Evaluate 用客户端当前的软件包匹配公告，软件包和发行版都没有变化时跳过
+func (am *AdvisoryManager) Evaluate(clientID string) {
+	packages, known := am.server.pkgManager.packages[clientID]
+	client, exists := am.server.clients[clientID]
+	if !known || !exists {
+		return
+	}
+
+	distro := client.Inventory.GetDistro()
+	distroVersion := client.Inventory.GetDistroVersion()
+	state := am.server.pkgManager.digests[clientID] + "|" + distro + "|" + distroVersion
+	if am.evaluated[clientID] == state {
+		return
+	}
+	am.evaluated[clientID] = state
+
+	var findings []*models.VulnerabilityFinding
+	for _, pkg := range packages {
+		findings = append(findings, am.match(pkg, distroEcosystems[distro], distroVersion)...)
+	}
+	sort.Slice(findings, func(i, j int) bool {
+		a, b := findings[i], findings[j]
+		if ra, rb := models.SeverityRank(a.Severity), models.SeverityRank(b.Severity); ra != rb {
+			return ra > rb
+		}
+		if a.AdvisoryID != b.AdvisoryID {
+			return a.AdvisoryID < b.AdvisoryID
+		}
+		return a.Package.Name < b.Package.Name
+	})
+
+	am.findings[clientID] = findings
+	am.updateAlerts(clientID, findings)
+}
+
+// match 返回软件包命中的公告
+// 客户端的发行版可以识别时，dpkg和rpm包只匹配该发行版及其版本的公告；否则匹配同类包管理器的所有发行版
+// 发行版的公告通常以源码包命名，因此包名和源码包名都会参与匹配
+func (am *AdvisoryManager) match(pkg *proto.Package, hostEcosystem string, distroVersion string) []*models.VulnerabilityFinding {
+	compare, ok := managerVersionCompare[pkg.Manager]
+	if !ok {
+		return nil
+	}
+
+	names := []string{pkg.Name}
+	if pkg.Source != "" {
+		names = append(names, pkg.Source)
+	}
+
+	var findings []*models.VulnerabilityFinding
+	seen := make(map[string]bool)
+	for _, name := range names {
+		for _, entry := range am.index.entries[packageIndexKey(pkg.Manager, name)] {
+			if seen[entry.advisory.ID] {
+				continue
+			}
+			if (pkg.Manager == "dpkg" || pkg.Manager == "rpm") && hostEcosystem != "" {
+				if entry.ecosystem != hostEcosystem || !releaseMatches(entry.release, distroVersion) {
+					continue
+				}
+			}
+
+			affected, fixed := entry.affected.Affects(pkg.Version, compare)
+			if !affected {
+				continue
+			}
+			seen[entry.advisory.ID] = true
+			findings = append(findings, &models.VulnerabilityFinding{
+				AdvisoryID:   entry.advisory.ID,
+				Aliases:      entry.advisory.Aliases,
+				Summary:      entry.advisory.Summary,
+				Severity:     entry.severity,
+				Package:      pkg,
+				FixedVersion: fixed,
+			})
+		}
+	}
+	return findings
+}
+
+// releaseMatches 判断公告的发行版版本是否适用于客户端，例如公告 "12" 适用于 "12.5"
+// 公告没有版本或版本不是数字（例如 Red Hat 的 "enterprise_linux"）时视为适用
+func releaseMatches(release string, distroVersion string) bool {
+	if release == "" || distroVersion == "" || release[0] < '0' || release[0] > '9' {
+		return true
+	}
+	return distroVersion == release || strings.HasPrefix(distroVersion, release+".")
+}
+
+// updateAlerts 为达到告警级别的公告触发告警，不再命中的公告恢复告警
+// 同一公告命中多个软件包（例如同一源码包的多个二进制包）时合并为一个告警
+func (am *AdvisoryManager) updateAlerts(clientID string, findings []*models.VulnerabilityFinding) {
+	cfg := am.server.cfg.Advisories
+	grouped := make(map[string][]*models.VulnerabilityFinding)
+	for _, finding := range findings {
+		if cfg.AlertsFor(finding.Severity) {
+			grouped[finding.AdvisoryID] = append(grouped[finding.AdvisoryID], finding)
+		}
+	}
+
+	for id, group := range grouped {
+		severity := models.SeverityWarning
+		if group[0].Severity == "critical" {
+			severity = models.SeverityCritical
+		}
+		details := make([]string, len(group))
+		for i, finding := range group {
+			details[i] = formatFindingPackage(finding)
+		}
+		am.server.alertManager.Raise(clientID, advisoryAlertKey(id), severity,
+			fmt.Sprintf("%s (%s): %s", id, group[0].Severity, group[0].Summary), details)
+	}
+
+	for _, alert := range am.server.alertManager.ListActive() {
+		if alert.ClientID != clientID {
+			continue
+		}
+		if id, ok := strings.CutPrefix(alert.Key, advisoryAlertKey("")); ok && grouped[id] == nil {
+			am.server.alertManager.Resolve(clientID, alert.Key, fmt.Sprintf("%s 已不再影响该主机或低于告警级别", id))
+		}
+	}
+}
+
+// formatFindingPackage 返回命中的软件包及修复版本，例如 "dpkg/libssl3 3.0.11-1~deb12u1，修复版本 3.0.11-1~deb12u2"
+func formatFindingPackage(finding *models.VulnerabilityFinding) string {
+	s := fmt.Sprintf("%s/%s %s", finding.Package.Manager, finding.Package.Name, finding.Package.Version)
+	if finding.FixedVersion != "" {
+		return s + "，修复版本 " + finding.FixedVersion
+	}
+	return s + "，暂无修复版本"
+}
+
+// GetFindings 返回客户端命中的公告，严重级别高的在前
+func (am *AdvisoryManager) GetFindings(clientID string) []*models.VulnerabilityFinding {
+	return am.findings[clientID]
+}
+
+// Report 汇总所有客户端命中的公告，受影响主机多的在前
+func (am *AdvisoryManager) Report() *models.VulnerabilityReport {
+	impacts := make(map[string]*models.AdvisoryImpact)
+	for clientID, findings := range am.findings {
+		client, exists := am.server.clients[clientID]
+		if !exists {
+			continue
+		}
+		for _, finding := range findings {
+			impact, ok := impacts[finding.AdvisoryID]
+			if !ok {
+				impact = &models.AdvisoryImpact{
+					AdvisoryID: finding.AdvisoryID,
+					Summary:    finding.Summary,
+					Severity:   finding.Severity,
+				}
+				impacts[finding.AdvisoryID] = impact
+			}
+			if !slices.Contains(impact.Hosts, client.Hostname) {
+				impact.Hosts = append(impact.Hosts, client.Hostname)
+			}
+			pkg := finding.Package.Name + " " + finding.Package.Version
+			if !slices.Contains(impact.Packages, pkg) {
+				impact.Packages = append(impact.Packages, pkg)
+			}
+		}
+	}
+
+	report := &models.VulnerabilityReport{AdvisoryCount: am.index.count}
+	for _, impact := range impacts {
+		sort.Strings(impact.Hosts)
+		sort.Strings(impact.Packages)
+		report.Impacts = append(report.Impacts, impact)
+	}
+	sort.Slice(report.Impacts, func(i, j int) bool {
+		a, b := report.Impacts[i], report.Impacts[j]
+		if ra, rb := models.SeverityRank(a.Severity), models.SeverityRank(b.Severity); ra != rb {
+			return ra > rb
+		}
+		if len(a.Hosts) != len(b.Hosts) {
+			return len(a.Hosts) > len(b.Hosts)
+		}
+		return a.AdvisoryID < b.AdvisoryID
+	})
+	return report
+}
+
+// RemoveClient 清除客户端的匹配结果
+func (am *AdvisoryManager) RemoveClient(clientID string) {
+	delete(am.findings, clientID)
+	delete(am.evaluated, clientID)
+}
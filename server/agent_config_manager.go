@@ -0,0 +1,180 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"GoMonitor/pkg/config"
+	"GoMonitor/proto"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// ConfigCommandType 是下发客户端配置时使用的命令类型
+const ConfigCommandType = "config"
+
+// AgentConfigManager 维护每个客户端期望的配置并负责下发
+type AgentConfigManager struct {
+	server      *Server
+	overrides   map[string]config.AgentConfigOverlay // client_id -> 通过CLI设置的覆盖配置
+	lastVersion int64
+}
+
+// NewAgentConfigManager 创建客户端配置管理器
+func NewAgentConfigManager(server *Server) *AgentConfigManager {
+	return &AgentConfigManager{
+		server:    server,
+		overrides: make(map[string]config.AgentConfigOverlay),
+	}
+}
+
+// nextVersion 生成新的配置版本号
+// 基于时间生成，保证服务端重启后版本号仍然大于客户端已应用的旧版本
+func (am *AgentConfigManager) nextVersion() int64 {
+	version := time.Now().UnixNano()
+	if version <= am.lastVersion {
+		version = am.lastVersion + 1
+	}
+	am.lastVersion = version
+	return version
+}
+
+// Resolve 计算客户端当前应生效的配置（不含版本号）
+func (am *AgentConfigManager) Resolve(clientID string) (*proto.AgentConfig, error) {
+	client, exists := am.server.clients[clientID]
+	if !exists {
+		return nil, errUnknownClient(clientID)
+	}
+
+	overlay := am.server.cfg.AgentConfig.Resolve(client.Hostname, client.Labels[config.GroupLabel])
+	if override, ok := am.overrides[clientID]; ok {
+		overlay = overlay.Merge(override)
+	}
+
+	return &proto.AgentConfig{
+		IntervalSeconds:        int32(overlay.Interval / time.Second),
+		EnabledCollectors:      overlay.Collectors,
+		InterfaceExclude:       overlay.InterfaceExclude,
+		PartitionExclude:       overlay.PartitionExclude,
+		AllowedCommandTypes:    overlay.AllowedCommandTypes,
+		LogRules:               am.server.logRuleMgr.ClientRules(),
+		EnabledCollectorsSet:   overlay.Collectors != nil,
+		InterfaceExcludeSet:    overlay.InterfaceExclude != nil,
+		PartitionExcludeSet:    overlay.PartitionExclude != nil,
+		AllowedCommandTypesSet: overlay.AllowedCommandTypes != nil,
+	}, nil
+}
+
+// CommandAllowed 判断客户端生效配置中的命令策略是否允许该命令类型，未设置命令策略时不做限制
+func (am *AgentConfigManager) CommandAllowed(clientID string, cmdType string) (bool, error) {
+	desired, err := am.Resolve(clientID)
+	if err != nil {
+		return false, err
+	}
+
+	if !desired.AllowedCommandTypesSet {
+		return true, nil
+	}
+	return config.CommandPolicy{AllowedCommandTypes: desired.AllowedCommandTypes}.Allows(cmdType), nil
+}
+
+// Refresh 重新计算客户端的期望配置，内容发生变化时生成新版本并下发
+func (am *AgentConfigManager) Refresh(clientID string) error {
+	client, exists := am.server.clients[clientID]
+	if !exists {
+		return errUnknownClient(clientID)
+	}
+
+	desired, err := am.Resolve(clientID)
+	if err != nil {
+		return err
+	}
+
+	if client.DesiredConfig != nil {
+		desired.Version = client.DesiredConfig.Version
+		if protobuf.Equal(desired, client.DesiredConfig) {
+			return nil
+		}
+	}
+
+	desired.Version = am.nextVersion()
+	client.DesiredConfig = desired
+	client.ConfigError = ""
+
+	am.Push(clientID)
+	return nil
+}
+
+// RefreshAll 在服务端配置重新加载后刷新所有客户端
+func (am *AgentConfigManager) RefreshAll() {
+	for clientID := range am.server.clients {
+		if err := am.Refresh(clientID); err != nil {
+			log.Printf("刷新客户端 %s 的配置失败: %v", clientID, err)
+		}
+	}
+}
+
+// Push 若客户端命令流在线，则把期望配置下发给客户端
+func (am *AgentConfigManager) Push(clientID string) {
+	client, exists := am.server.clients[clientID]
+	if !exists || client.DesiredConfig == nil {
+		return
+	}
+
+	stream, ok := am.server.clientStreams[clientID]
+	if !ok {
+		return
+	}
+
+	cmd := &proto.Command{
+		CommandId:   fmt.Sprintf("config-%d", client.DesiredConfig.Version),
+		CommandType: ConfigCommandType,
+		IssuedAt:    time.Now().Unix(),
+		AgentConfig: client.DesiredConfig,
+	}
+
+	if err := stream.Send(cmd); err != nil {
+		log.Printf("向客户端 %s 下发配置失败: %v", clientID, err)
+		return
+	}
+
+	log.Printf("向客户端 %s 下发配置，版本: %d", clientID, client.DesiredConfig.Version)
+}
+
+// SetOverride 为单个客户端设置覆盖配置并立即下发
+func (am *AgentConfigManager) SetOverride(clientID string, overlay config.AgentConfigOverlay) error {
+	if err := overlay.Validate("override"); err != nil {
+		return err
+	}
+
+	if _, exists := am.server.clients[clientID]; !exists {
+		return errUnknownClient(clientID)
+	}
+
+	am.overrides[clientID] = overlay
+	return am.Refresh(clientID)
+}
+
+// HandleAck 记录客户端对配置版本的确认
+func (am *AgentConfigManager) HandleAck(ack *proto.ConfigAck) error {
+	client, exists := am.server.clients[ack.ClientId]
+	if !exists {
+		return errUnknownClient(ack.ClientId)
+	}
+
+	if !ack.Success {
+		client.ConfigError = ack.Error
+		return nil
+	}
+
+	if ack.Version > client.AppliedConfigVersion {
+		client.AppliedConfigVersion = ack.Version
+	}
+	client.ConfigError = ""
+	return nil
+}
+
+// RemoveClient 删除客户端的覆盖配置
+func (am *AgentConfigManager) RemoveClient(clientID string) {
+	delete(am.overrides, clientID)
+}
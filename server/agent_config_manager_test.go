@@ -0,0 +1,38 @@
+package main
+
+import (
+	"testing"
+
+	"GoMonitor/pkg/config"
+)
+
+func TestAgentConfigEmptyListIsSet(t *testing.T) {
+	s := NewServer(config.DefaultServerConfig())
+	id, err := s.clientManager.RegisterClient(registerRequest("", "web-1"), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	desired, err := s.agentCfgMgr.Resolve(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if desired.EnabledCollectorsSet || desired.AllowedCommandTypesSet {
+		t.Fatalf("未设置的列表被标记为已设置: %v", desired)
+	}
+	if _, err := s.SendCommandToClient(id, "shell", "uptime", 10); err != nil {
+		t.Fatalf("未设置命令策略时命令被拒绝: %v", err)
+	}
+
+	// 空列表表示不启用任何采集器、禁止所有命令，而不是沿用下层配置
+	if err := s.agentCfgMgr.SetOverride(id, config.AgentConfigOverlay{Collectors: []string{}, AllowedCommandTypes: []string{}}); err != nil {
+		t.Fatal(err)
+	}
+	desired = s.clients[id].DesiredConfig
+	if !desired.EnabledCollectorsSet || !desired.AllowedCommandTypesSet || desired.InterfaceExcludeSet {
+		t.Fatalf("设置标记 = %v", desired)
+	}
+	if _, err := s.SendCommandToClient(id, "shell", "uptime", 10); err == nil {
+		t.Fatal("客户端配置禁止的命令未被拒绝")
+	}
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"sort"
+	"time"
+
+	"GoMonitor/pkg/models"
+	"github.com/google/uuid"
+)
+
+// AlertManager 维护所有告警的状态，并在告警触发、升级和恢复时发送通知
+type AlertManager struct {
+	server   *Server
+	active   map[string]*models.Alert // client_id/key -> 告警
+	resolved []*models.Alert          // 已恢复的告警，按恢复时间排列
+	notifier *Notifier
+}
+
+// NewAlertManager 创建告警管理器
+func NewAlertManager(server *Server) *AlertManager {
+	return &AlertManager{
+		server:   server,
+		active:   make(map[string]*models.Alert),
+		notifier: NewNotifier(),
+	}
+}
+
+func alertKey(clientID string, key string) string {
+	return clientID + "/" + key
+}
+
+// Raise 触发或更新告警
+// 新告警或严重级别变化时发送通知；已触发的同级别告警只更新内容，避免重复通知
+func (am *AlertManager) Raise(clientID string, key string, severity string, message string, details []string) {
+	now := time.Now()
+	id := alertKey(clientID, key)
+
+	alert, exists := am.active[id]
+	if exists && alert.Severity == severity {
+		alert.Message = message
+		alert.Details = details
+		alert.UpdatedAt = now
+		return
+	}
+
+	if !exists {
+		alert = &models.Alert{
+			ID:        uuid.New().String(),
+			ClientID:  clientID,
+			Key:       key,
+			Firing:    true,
+			StartedAt: now,
+		}
+		if client, ok := am.server.clients[clientID]; ok {
+			alert.Hostname = client.Hostname
+		}
+		am.active[id] = alert
+	}
+
+	alert.Severity = severity
+	alert.Message = message
+	alert.Details = details
+	alert.UpdatedAt = now
+
+	am.notifier.Notify(am.server.cfg.Notifications, alert)
+}
+
+// Resolve 恢复告警，告警不存在时忽略
+func (am *AlertManager) Resolve(clientID string, key string, message string) {
+	id := alertKey(clientID, key)
+	alert, exists := am.active[id]
+	if !exists {
+		return
+	}
+
+	now := time.Now()
+	alert.Firing = false
+	alert.Message = message
+	alert.UpdatedAt = now
+	alert.ResolvedAt = now
+
+	delete(am.active, id)
+	am.resolved = append(am.resolved, alert)
+	if overflow := len(am.resolved) - am.server.cfg.Storage.MaxEvents; overflow > 0 {
+		am.resolved = append([]*models.Alert(nil), am.resolved[overflow:]...)
+	}
+
+	am.notifier.Notify(am.server.cfg.Notifications, alert)
+}
+
+// IsFiring 判断告警是否处于触发状态
+func (am *AlertManager) IsFiring(clientID string, key string) bool {
+	_, exists := am.active[alertKey(clientID, key)]
+	return exists
+}
+
+// ResolveClient 恢复客户端的所有告警，用于客户端被移除时
+func (am *AlertManager) ResolveClient(clientID string) {
+	for _, alert := range am.active {
+		if alert.ClientID == clientID {
+			am.Resolve(clientID, alert.Key, "客户端已移除")
+		}
+	}
+}
+
+// ListActive 返回所有触发中的告警，严重级别高的在前
+func (am *AlertManager) ListActive() []*models.Alert {
+	alerts := make([]*models.Alert, 0, len(am.active))
+	for _, alert := range am.active {
+		alerts = append(alerts, alert)
+	}
+
+	sort.Slice(alerts, func(i, j int) bool {
+		if ri, rj := models.AlertSeverityRank(alerts[i].Severity), models.AlertSeverityRank(alerts[j].Severity); ri != rj {
+			return ri > rj
+		}
+		return alerts[i].StartedAt.Before(alerts[j].StartedAt)
+	})
+	return alerts
+}
+
+// ListResolved 返回最近恢复的告警，最新的在前
+func (am *AlertManager) ListResolved(limit int) []*models.Alert {
+	alerts := []*models.Alert{}
+	for i := len(am.resolved) - 1; i >= 0; i-- {
+		alerts = append(alerts, am.resolved[i])
+		if limit > 0 && len(alerts) >= limit {
+			break
+		}
+	}
+	return alerts
+}
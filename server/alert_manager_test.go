@@ -0,0 +1,40 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"GoMonitor/pkg/config"
+	"GoMonitor/pkg/models"
+)
+
+func TestListActiveOrdersBySeverityThenStart(t *testing.T) {
+	s := NewServer(config.DefaultServerConfig())
+	raised := []struct {
+		key      string
+		severity string
+	}{
+		{"info-old", models.SeverityInfo},
+		{"warning-old", models.SeverityWarning},
+		{"critical-old", models.SeverityCritical},
+		{"info-new", models.SeverityInfo},
+		{"warning-new", models.SeverityWarning},
+		{"critical-new", models.SeverityCritical},
+	}
+	start := time.Now()
+	for i, alert := range raised {
+		s.alertManager.Raise("c1", alert.key, alert.severity, alert.key, nil)
+		s.alertManager.active[alertKey("c1", alert.key)].StartedAt = start.Add(time.Duration(i) * time.Second)
+	}
+
+	want := []string{"critical-old", "critical-new", "warning-old", "warning-new", "info-old", "info-new"}
+	alerts := s.alertManager.ListActive()
+	if len(alerts) != len(want) {
+		t.Fatalf("得到 %d 个告警，期望 %d 个", len(alerts), len(want))
+	}
+	for i, alert := range alerts {
+		if alert.Key != want[i] {
+			t.Fatalf("第 %d 个告警为 %s，期望 %s", i, alert.Key, want[i])
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"GoMonitor/pkg/models"
+	"GoMonitor/proto"
+)
+
+// CheckManager 跟踪各客户端检查的当前状态和状态变化历史，并据此触发告警
+type CheckManager struct {
+	server  *Server
+	states  map[string]map[string]*models.CheckStatus // client_id -> 检查名称 -> 当前状态
+	history map[string][]*models.CheckStateChange     // client_id -> 状态变化历史
+}
+
+// NewCheckManager 创建检查管理器
+func NewCheckManager(server *Server) *CheckManager {
+	return &CheckManager{
+		server:  server,
+		states:  make(map[string]map[string]*models.CheckStatus),
+		history: make(map[string][]*models.CheckStateChange),
+	}
+}
+
+// checkAlertKey 返回检查对应的告警标识
+func checkAlertKey(name string) string {
+	return "check:" + name
+}
+
+// checkSeverity 把检查状态映射为告警级别
+func checkSeverity(state proto.CheckState) string {
+	switch state {
+	case proto.CheckState_CHECK_OK:
+		return models.SeverityInfo
+	case proto.CheckState_CHECK_WARNING:
+		return models.SeverityWarning
+	default:
+		return models.SeverityCritical
+	}
+}
+
+// Update 处理客户端上报的检查结果
+// 状态变化时记录历史和事件；非OK状态触发告警，恢复为OK时解除告警
+// 本次未上报的检查（已从客户端配置中删除，或客户端重启后尚未运行）解除告警并清除状态，再次上报时按首次上报处理
+func (cm *CheckManager) Update(clientID string, results []*proto.CheckResult) {
+	states, exists := cm.states[clientID]
+	if !exists {
+		if len(results) == 0 {
+			return
+		}
+		states = make(map[string]*models.CheckStatus)
+		cm.states[clientID] = states
+	}
+
+	now := time.Now()
+	reported := make(map[string]bool, len(results))
+
+	for _, result := range results {
+		reported[result.Name] = true
+		previous, known := states[result.Name]
+		if known && previous.Result.State == result.State {
+			previous.Result = result
+		} else {
+			states[result.Name] = &models.CheckStatus{Result: result, Since: now}
+
+			from := proto.CheckState_CHECK_OK
+			if known {
+				from = previous.Result.State
+			}
+			// 首次上报OK状态不算状态变化
+			if known || result.State != proto.CheckState_CHECK_OK {
+				cm.recordChange(clientID, result, from, now)
+			}
+		}
+
+		key := checkAlertKey(result.Name)
+		message := fmt.Sprintf("检查 %s 状态为 %s: %s", result.Name, models.CheckStateName(result.State), result.Output)
+		if result.State == proto.CheckState_CHECK_OK {
+			cm.server.alertManager.Resolve(clientID, key, message)
+		} else {
+			var details []string
+			if result.LongOutput != "" {
+				details = []string{result.LongOutput}
+			}
+			cm.server.alertManager.Raise(clientID, key, checkSeverity(result.State), message, details)
+		}
+	}
+
+	for name := range states {
+		if !reported[name] {
+			cm.server.alertManager.Resolve(clientID, checkAlertKey(name), fmt.Sprintf("检查 %s 已不再上报", name))
+			delete(states, name)
+		}
+	}
+}
+
+// recordChange 记录一次状态变化，超过保留数量时淘汰最早的记录
+func (cm *CheckManager) recordChange(clientID string, result *proto.CheckResult, from proto.CheckState, now time.Time) {
+	change := &models.CheckStateChange{
+		CheckName: result.Name,
+		From:      from,
+		To:        result.State,
+		Output:    result.Output,
+		Timestamp: now,
+	}
+
+	history := append(cm.history[clientID], change)
+	if overflow := len(history) - cm.server.cfg.Storage.MaxCheckHistory; overflow > 0 {
+		history = append([]*models.CheckStateChange(nil), history[overflow:]...)
+	}
+	cm.history[clientID] = history
+
+	cm.server.eventManager.Record(clientID, "check_state", checkSeverity(result.State),
+		fmt.Sprintf("检查 %s 状态从 %s 变为 %s: %s",
+			result.Name, models.CheckStateName(from), models.CheckStateName(result.State), result.Output))
+}
+
+// GetStates 返回客户端所有检查的当前状态
+func (cm *CheckManager) GetStates(clientID string) map[string]*models.CheckStatus {
+	return cm.states[clientID]
+}
+
+// GetHistory 返回客户端的检查状态变化历史，最新的在前
+func (cm *CheckManager) GetHistory(clientID string) []*models.CheckStateChange {
+	history := cm.history[clientID]
+	result := make([]*models.CheckStateChange, len(history))
+	for i, change := range history {
+		result[len(history)-1-i] = change
+	}
+	return result
+}
+
+// TrimHistory 按新的保留数量裁剪所有客户端的历史
+func (cm *CheckManager) TrimHistory(max int) {
+	for clientID, history := range cm.history {
+		if overflow := len(history) - max; overflow > 0 {
+			cm.history[clientID] = append([]*models.CheckStateChange(nil), history[overflow:]...)
+		}
+	}
+}
+
+// RemoveClient 清除客户端的检查状态和历史
+func (cm *CheckManager) RemoveClient(clientID string) {
+	delete(cm.states, clientID)
+	delete(cm.history, clientID)
+}
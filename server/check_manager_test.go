@@ -0,0 +1,34 @@
+package main
+
+import (
+	"testing"
+
+	"GoMonitor/pkg/config"
+	"GoMonitor/proto"
+)
+
+func TestCheckUpdateResolvesRemovedChecks(t *testing.T) {
+	s := NewServer(config.DefaultServerConfig())
+	s.checkManager.Update("c1", []*proto.CheckResult{
+		{Name: "disk", State: proto.CheckState_CHECK_CRITICAL, Output: "/ 已用 97%"},
+		{Name: "nginx", State: proto.CheckState_CHECK_WARNING, Output: "响应慢"},
+	})
+	if len(s.alertManager.ListActive()) != 2 {
+		t.Fatalf("触发中的告警 = %v", s.alertManager.ListActive())
+	}
+
+	// disk 检查从客户端配置中删除
+	s.checkManager.Update("c1", []*proto.CheckResult{
+		{Name: "nginx", State: proto.CheckState_CHECK_WARNING, Output: "响应慢"},
+	})
+	if _, firing := s.alertManager.active[alertKey("c1", checkAlertKey("disk"))]; firing {
+		t.Fatal("已删除检查的告警未解除")
+	}
+	if _, firing := s.alertManager.active[alertKey("c1", checkAlertKey("nginx"))]; !firing {
+		t.Fatal("仍在上报的检查的告警被解除")
+	}
+	states := s.checkManager.GetStates("c1")
+	if _, ok := states["disk"]; ok || len(states) != 1 {
+		t.Fatalf("检查状态 = %v", states)
+	}
+}
@@ -0,0 +1,65 @@
+package cli
+
+import (
+	"fmt"
+
+	"GoMonitor/pkg/models"
+
+	"github.com/manifoldco/promptui"
+)
+
+// handleViewAccounts 显示客户端的本地账户、组成员和sudo权限
+func handleViewAccounts(s ServerInterface) {
+	clients := s.ListClients()
+	if len(clients) == 0 {
+		fmt.Println("目前没有已连接的客户端")
+		return
+	}
+
+	clientIDs := make([]string, len(clients))
+	for i, client := range clients {
+		clientIDs[i] = fmt.Sprintf("%s (%s)", client.ID, client.Hostname)
+	}
+
+	selectPrompt := promptui.Select{
+		Label: "选择客户端",
+		Items: clientIDs,
+		Size:  10,
+	}
+
+	idx, _, err := selectPrompt.Run()
+	if err != nil {
+		fmt.Printf("选择错误: %v\n", err)
+		return
+	}
+
+	inventory, err := s.GetAccounts(clients[idx].ID)
+	if err != nil {
+		fmt.Printf("错误: %v\n", err)
+		return
+	}
+
+	fmt.Printf("\n===== 本地账户 =====\n")
+	fmt.Print(models.FormatAccountInventory(inventory))
+
+	fmt.Println("\n按Enter键继续...")
+	fmt.Scanln()
+}
+
+// handleViewRootAccess 列出所有客户端上拥有root权限的账户
+func handleViewRootAccess(s ServerInterface) {
+	access := s.ListRootAccess()
+
+	fmt.Printf("\n===== root权限分布 (%d) =====\n", len(access))
+	if len(access) == 0 {
+		fmt.Println("尚未收到任何客户端的账户清单")
+	}
+	for _, entry := range access {
+		fmt.Printf("%s (%s): %s uid=%d shell=%s\n", entry.Hostname, entry.ClientID,
+			entry.Account.GetName(), entry.Account.GetUid(), entry.Account.GetShell())
+		fmt.Printf("  原因: %s\n", models.RootReason(entry.Account))
+	}
+
+	fmt.Println("\n按Enter键继续...")
+	fmt.Scanln()
+}
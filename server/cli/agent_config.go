@@ -0,0 +1,157 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"GoMonitor/pkg/config"
+	"GoMonitor/proto"
+	"github.com/manifoldco/promptui"
+)
+
+// handleViewConfigStatus 显示各客户端的期望配置、已应用版本以及是否存在配置漂移
+func handleViewConfigStatus(s ServerInterface) {
+	clients := s.ListClients()
+	if len(clients) == 0 {
+		fmt.Println("目前没有已连接的客户端")
+		return
+	}
+
+	drifted := 0
+	for _, client := range clients {
+		status := "已同步"
+		if client.ConfigDrifted() {
+			status = "漂移"
+			drifted++
+		}
+		if client.ConfigError != "" {
+			status = "应用失败: " + client.ConfigError
+		}
+
+		fmt.Printf("\n%s (%s) | 状态: %s\n", client.ID, client.Hostname, status)
+		fmt.Printf("  期望版本: %d | 已应用版本: %d\n",
+			client.DesiredConfig.GetVersion(), client.AppliedConfigVersion)
+		fmt.Printf("  %s\n", formatAgentConfig(client.DesiredConfig))
+	}
+
+	fmt.Printf("\n共 %d 个客户端，%d 个存在配置漂移\n", len(clients), drifted)
+
+	fmt.Println("\n按Enter键继续...")
+	fmt.Scanln()
+}
+
+// handleSetClientConfig 为单个客户端设置覆盖配置，留空的项沿用服务端配置文件中的值
+func handleSetClientConfig(s ServerInterface) {
+	clients := s.ListClients()
+	if len(clients) == 0 {
+		fmt.Println("目前没有已连接的客户端")
+		return
+	}
+
+	clientIDs := make([]string, len(clients))
+	for i, client := range clients {
+		clientIDs[i] = fmt.Sprintf("%s (%s)", client.ID, client.Hostname)
+	}
+
+	selectPrompt := promptui.Select{
+		Label: "选择客户端",
+		Items: clientIDs,
+		Size:  10,
+	}
+
+	idx, _, err := selectPrompt.Run()
+	if err != nil {
+		fmt.Printf("选择错误: %v\n", err)
+		return
+	}
+
+	intervalPrompt := promptui.Prompt{
+		Label: "上报间隔(秒，留空不覆盖)",
+		Validate: func(input string) error {
+			if input == "" {
+				return nil
+			}
+			if _, err := strconv.Atoi(input); err != nil {
+				return fmt.Errorf("请输入有效的数字")
+			}
+			return nil
+		},
+	}
+
+	intervalStr, err := intervalPrompt.Run()
+	if err != nil {
+		fmt.Printf("输入错误: %v\n", err)
+		return
+	}
+
+	collectorsPrompt := promptui.Prompt{
+		Label: fmt.Sprintf("启用的采集器(逗号分隔，可选 %s，留空不覆盖)", strings.Join(config.KnownCollectors, ",")),
+	}
+
+	collectorsStr, err := collectorsPrompt.Run()
+	if err != nil {
+		fmt.Printf("输入错误: %v\n", err)
+		return
+	}
+
+	overlay := config.AgentConfigOverlay{}
+	if intervalStr != "" {
+		seconds, _ := strconv.Atoi(intervalStr)
+		overlay.Interval = time.Duration(seconds) * time.Second
+	}
+	if collectorsStr != "" {
+		for _, name := range strings.Split(collectorsStr, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				overlay.Collectors = append(overlay.Collectors, name)
+			}
+		}
+	}
+
+	if err := s.SetClientConfigOverride(clients[idx].ID, overlay); err != nil {
+		fmt.Printf("设置配置失败: %v\n", err)
+	} else {
+		fmt.Println("配置已更新并下发")
+	}
+
+	fmt.Println("\n按Enter键继续...")
+	fmt.Scanln()
+}
+
+// formatAgentConfig 将下发配置格式化为单行文本
+func formatAgentConfig(cfg *proto.AgentConfig) string {
+	if cfg == nil {
+		return "（无）"
+	}
+
+	orDefault := func(items []string, set bool) string {
+		if !set && len(items) == 0 {
+			return "本地配置"
+		}
+		if len(items) == 0 {
+			return "（空）"
+		}
+		return strings.Join(items, ",")
+	}
+
+	interval := "本地配置"
+	if cfg.IntervalSeconds > 0 {
+		interval = fmt.Sprintf("%ds", cfg.IntervalSeconds)
+	}
+
+	text := fmt.Sprintf("间隔: %s | 采集器: %s | 排除接口: %s | 排除挂载点: %s | 命令类型: %s",
+		interval,
+		orDefault(cfg.EnabledCollectors, cfg.EnabledCollectorsSet),
+		orDefault(cfg.InterfaceExclude, cfg.InterfaceExcludeSet),
+		orDefault(cfg.PartitionExclude, cfg.PartitionExcludeSet),
+		orDefault(cfg.AllowedCommandTypes, cfg.AllowedCommandTypesSet))
+	if len(cfg.LogRules) > 0 {
+		names := make([]string, len(cfg.LogRules))
+		for i, rule := range cfg.LogRules {
+			names[i] = rule.Name
+		}
+		text += " | 日志规则: " + strings.Join(names, ",")
+	}
+	return text
+}
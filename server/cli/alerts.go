@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"GoMonitor/pkg/models"
+)
+
+// 告警和事件视图中显示的最近记录数
+const (
+	recentResolvedAlerts = 20
+	recentEvents         = 50
+)
+
+// handleViewAlerts 显示触发中的告警和最近恢复的告警
+func handleViewAlerts(s ServerInterface) {
+	active, resolved := s.ListAlerts(recentResolvedAlerts)
+
+	fmt.Printf("\n===== 触发中的告警 (%d) =====\n", len(active))
+	if len(active) == 0 {
+		fmt.Println("当前没有触发中的告警")
+	}
+	for _, alert := range active {
+		fmt.Printf("[%s] %s (%s) %s: %s\n",
+			strings.ToUpper(alert.Severity), alert.Hostname, alert.ClientID, alert.Key, alert.Message)
+		fmt.Printf("  开始于: %s | 持续: %s\n",
+			alert.StartedAt.Format(time.RFC3339), time.Since(alert.StartedAt).Round(time.Second))
+		for _, detail := range alert.Details {
+			fmt.Printf("  %s\n", detail)
+		}
+	}
+
+	fmt.Printf("\n===== 最近恢复的告警 =====\n")
+	if len(resolved) == 0 {
+		fmt.Println("暂无")
+	}
+	for _, alert := range resolved {
+		fmt.Printf("%s %s (%s) %s: %s (持续 %s)\n",
+			alert.ResolvedAt.Format(time.RFC3339), alert.Hostname, alert.ClientID, alert.Key,
+			alert.Message, alert.ResolvedAt.Sub(alert.StartedAt).Round(time.Second))
+	}
+
+	fmt.Println("\n按Enter键继续...")
+	fmt.Scanln()
+}
+
+// handleViewEvents 显示所有客户端最近的事件
+func handleViewEvents(s ServerInterface) {
+	events := s.ListEvents("", recentEvents)
+
+	fmt.Printf("\n===== 最近事件 =====\n")
+	if len(events) == 0 {
+		fmt.Println("暂无事件")
+	}
+	for _, event := range events {
+		fmt.Println(formatEvent(event))
+	}
+
+	fmt.Println("\n按Enter键继续...")
+	fmt.Scanln()
+}
+
+// formatEvent 格式化单个事件
+func formatEvent(event *models.Event) string {
+	return fmt.Sprintf("%s [%s] %s (%s) %s: %s",
+		event.Timestamp.Format(time.RFC3339), strings.ToUpper(event.Severity),
+		event.Hostname, event.ClientID, event.Type, event.Message)
+}
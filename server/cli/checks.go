@@ -0,0 +1,107 @@
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"GoMonitor/pkg/models"
+	"GoMonitor/proto"
+	"github.com/manifoldco/promptui"
+)
+
+// handleViewCheckStates 显示客户端所有检查的当前状态和状态变化历史
+func handleViewCheckStates(s ServerInterface) {
+	clients := s.ListClients()
+	if len(clients) == 0 {
+		fmt.Println("目前没有已连接的客户端")
+		return
+	}
+
+	clientIDs := make([]string, len(clients))
+	for i, client := range clients {
+		clientIDs[i] = fmt.Sprintf("%s (%s)", client.ID, client.Hostname)
+	}
+
+	selectPrompt := promptui.Select{
+		Label: "选择客户端",
+		Items: clientIDs,
+		Size:  10,
+	}
+
+	idx, _, err := selectPrompt.Run()
+	if err != nil {
+		fmt.Printf("选择错误: %v\n", err)
+		return
+	}
+
+	states, history, err := s.GetCheckStates(clients[idx].ID)
+	if err != nil {
+		fmt.Printf("错误: %v\n", err)
+		return
+	}
+
+	fmt.Printf("\n===== 检查状态 =====\n")
+	if len(states) == 0 {
+		fmt.Println("该客户端没有上报检查结果")
+	}
+
+	names := make([]string, 0, len(states))
+	for name := range states {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		status := states[name]
+		result := status.Result
+		fmt.Printf("[%s] %s: %s\n", models.CheckStateName(result.State), name, result.Output)
+		fmt.Printf("  持续时间: %s | 最后执行: %s | 耗时: %dms\n",
+			time.Since(status.Since).Round(time.Second),
+			time.Unix(result.ExecutedAt, 0).Format(time.RFC3339), result.DurationMs)
+		if result.LongOutput != "" {
+			fmt.Printf("  %s\n", result.LongOutput)
+		}
+		for _, pd := range result.PerfData {
+			fmt.Printf("  %s\n", formatPerfData(pd))
+		}
+	}
+
+	fmt.Printf("\n===== 状态变化历史 =====\n")
+	if len(history) == 0 {
+		fmt.Println("暂无状态变化")
+	}
+	for _, change := range history {
+		fmt.Printf("%s %s: %s -> %s (%s)\n",
+			change.Timestamp.Format(time.RFC3339), change.CheckName,
+			models.CheckStateName(change.From), models.CheckStateName(change.To), change.Output)
+	}
+
+	fmt.Println("\n按Enter键继续...")
+	fmt.Scanln()
+}
+
+// formatPerfData 格式化一项性能数据，例如 "load1 = 0.52 (警告: 5, 严重: 10)"
+func formatPerfData(pd *proto.PerfData) string {
+	text := fmt.Sprintf("%s = %g%s", pd.Label, pd.Value, pd.Unit)
+
+	var limits []string
+	if pd.Warn != "" {
+		limits = append(limits, "警告: "+pd.Warn)
+	}
+	if pd.Crit != "" {
+		limits = append(limits, "严重: "+pd.Crit)
+	}
+	if pd.Min != nil {
+		limits = append(limits, fmt.Sprintf("最小: %g", *pd.Min))
+	}
+	if pd.Max != nil {
+		limits = append(limits, fmt.Sprintf("最大: %g", *pd.Max))
+	}
+
+	if len(limits) > 0 {
+		text += " (" + strings.Join(limits, ", ") + ")"
+	}
+	return text
+}
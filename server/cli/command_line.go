@@ -1,13 +1,17 @@
 package cli
 
 import (
+	"GoMonitor/pkg/config"
 	"GoMonitor/pkg/models"
 	"GoMonitor/proto"
 	"errors"
 	"fmt"
+	"net"
 	"os"
 	"runtime"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"GoMonitor/pkg/utils"
@@ -20,6 +24,20 @@ type ServerInterface interface {
 	GetClientInfo(clientID string) (*models.ClientInfo, error)
 	SendCommandToClient(clientID string, cmdType string, content string, timeout int32) (string, error)
 	GetCommandResult(cmdID string) (*proto.CommandResult, error)
+	SetClientConfigOverride(clientID string, overlay config.AgentConfigOverlay) error
+	GetCheckStates(clientID string) (map[string]*models.CheckStatus, []*models.CheckStateChange, error)
+	ListAlerts(resolvedLimit int) ([]*models.Alert, []*models.Alert)
+	ListEvents(clientID string, limit int) []*models.Event
+	SearchPackages(name string, versionPrefix string) ([]*models.PackageMatch, error)
+	GetPackageHistory(clientID string) ([]*models.PackageChange, error)
+	GetVulnerabilities(clientID string) ([]*models.VulnerabilityFinding, error)
+	GetVulnerabilityReport() *models.VulnerabilityReport
+	GetIntegrityReport(clientID string) (*proto.IntegrityReport, error)
+	SearchLogs(query models.LogQuery) ([]*models.LogEntry, error)
+	GetLogins(clientID string) (*proto.LoginInfo, error)
+	GetAccounts(clientID string) (*proto.AccountInventory, error)
+	ListRootAccess() []*models.RootAccess
+	SearchScheduledJobs(pattern string) ([]*models.ScheduledJobMatch, error)
 }
 
 // ClientInfo 定义CLI需要的客户端信息结构
@@ -100,10 +118,29 @@ func RunCommandLine(s ServerInterface) {
 				"获取客户端信息",
 				"向客户端发送命令",
 				"查看命令执行结果",
+				"查看客户端配置状态",
+				"设置客户端配置",
+				"查看检查状态",
+				"查看告警",
+				"查看事件",
+				"查看硬件清单",
+				"导出硬件清单",
+				"搜索软件包",
+				"查看软件包变化",
+				"查看主机漏洞",
+				"查看漏洞汇总",
+				"查看文件完整性",
+				"重建文件基线",
+				"搜索日志",
+				"实时查看日志",
+				"查看登录会话",
+				"查看本地账户",
+				"查看root权限分布",
+				"查看计划任务",
 				"退出",
 			},
 			HideSelected: false,
-			Size:         10,
+			Size:         24,
 		}
 
 		idx, _, err := prompt.Run()
@@ -122,6 +159,44 @@ func RunCommandLine(s ServerInterface) {
 		case 3:
 			handleViewCommandResult(s)
 		case 4:
+			handleViewConfigStatus(s)
+		case 5:
+			handleSetClientConfig(s)
+		case 6:
+			handleViewCheckStates(s)
+		case 7:
+			handleViewAlerts(s)
+		case 8:
+			handleViewEvents(s)
+		case 9:
+			handleViewInventory(s)
+		case 10:
+			handleExportInventory(s)
+		case 11:
+			handleSearchPackages(s)
+		case 12:
+			handleViewPackageHistory(s)
+		case 13:
+			handleViewVulnerabilities(s)
+		case 14:
+			handleViewVulnerabilityReport(s)
+		case 15:
+			handleViewIntegrity(s)
+		case 16:
+			handleRebaseline(s)
+		case 17:
+			handleSearchLogs(s)
+		case 18:
+			handleFollowLogs(s)
+		case 19:
+			handleViewLogins(s)
+		case 20:
+			handleViewAccounts(s)
+		case 21:
+			handleViewRootAccess(s)
+		case 22:
+			handleSearchScheduledJobs(s)
+		case 23:
 			fmt.Println("退出程序")
 			os.Exit(0)
 		}
@@ -139,8 +214,8 @@ func handleListClients(s ServerInterface) {
 	fmt.Printf("\n共有 %d 个客户端:\n", len(clients))
 	clientsDisplay := make([]string, len(clients))
 	for i, client := range clients {
-		clientsDisplay[i] = fmt.Sprintf("ID: %s | 主机名: %s | IP: %s",
-			client.ID, client.Hostname, client.IPAddress)
+		clientsDisplay[i] = fmt.Sprintf("ID: %s | 主机名: %s | IP: %s | 观测地址: %s",
+			client.ID, client.Hostname, client.IPAddress, client.ObservedAddress)
 	}
 
 	selectPrompt := promptui.Select{
@@ -194,22 +269,176 @@ func handleGetClientInfo(s ServerInterface) {
 	fmt.Printf("ID: %s\n", client.ID)
 	fmt.Printf("主机名: %s\n", client.Hostname)
 	fmt.Printf("IP地址: %s\n", client.IPAddress)
+	if client.ObservedAddress != "" {
+		if client.BehindNAT() {
+			fmt.Printf("观测地址: %s (与上报的地址不同，可能位于NAT或代理之后)\n", client.ObservedAddress)
+		} else {
+			fmt.Printf("观测地址: %s\n", client.ObservedAddress)
+		}
+	}
+	if len(client.IPAddresses) > 0 {
+		fmt.Printf("所有地址: %s\n", models.FormatIPAddresses(client.IPAddresses))
+	}
 	fmt.Printf("MAC地址: %s\n", client.MACAddress)
 	fmt.Printf("操作系统: %s\n", client.OSInfo)
+	if client.Inventory != nil {
+		fmt.Printf("硬件: %s\n", inventorySummary(client.Inventory))
+	}
+	if len(client.Labels) > 0 {
+		fmt.Printf("标签: %s\n", formatLabels(client.Labels))
+	}
 	fmt.Printf("最后活跃时间: %s\n", client.LastSeen.Format(time.RFC3339))
 
 	if client.Info != nil {
 		fmt.Printf("\n===== 系统信息 =====\n")
-		fmt.Printf("CPU使用率: %.2f%%\n", client.Info.CpuInfo.CpuUsagePercent)
-		fmt.Printf("CPU核心数: %d\n", client.Info.CpuInfo.CpuCores)
-		fmt.Printf("内存使用率: %.2f%%\n", client.Info.MemoryInfo.MemoryUsagePercent)
-		fmt.Printf("总内存: %s\n", utils.FormatBytes(client.Info.MemoryInfo.TotalMemory))
-		fmt.Printf("已用内存: %s\n", utils.FormatBytes(client.Info.MemoryInfo.UsedMemory))
-		fmt.Printf("磁盘分区数: %d\n", len(client.Info.DiskInfo.Partitions))
-
-		for i, partition := range client.Info.DiskInfo.Partitions {
-			fmt.Printf("  分区 %d: %s, 使用率: %.2f%%\n",
+		fmt.Printf("CPU使用率: %.2f%%\n", client.Info.GetCpuInfo().GetCpuUsagePercent())
+		cpuInfo := client.Info.GetCpuInfo()
+		if cpuInfo.GetModelName() != "" {
+			fmt.Printf("CPU型号: %s @ %.0f MHz\n", cpuInfo.GetModelName(), cpuInfo.GetMhz())
+		}
+		if cpuInfo.GetLogicalCores() > 0 {
+			fmt.Printf("CPU核心数: %d 颗CPU, %d 物理核心, %d 逻辑核心\n",
+				cpuInfo.GetSockets(), cpuInfo.GetPhysicalCores(), cpuInfo.GetLogicalCores())
+		} else {
+			fmt.Printf("CPU核心数: %d\n", cpuInfo.GetCpuCores())
+		}
+		load1, load5, load15 := models.LoadAverages(cpuInfo)
+		fmt.Printf("平均负载: %.2f, %.2f, %.2f\n", load1, load5, load15)
+		if cpuInfo.GetSampleSeconds() > 0 {
+			fmt.Printf("  统计窗口: %.0f秒 | 用户态: %.2f%% | 内核态: %.2f%% | I/O等待: %.2f%% | 被抢占: %.2f%% | 空闲: %.2f%%\n",
+				cpuInfo.GetSampleSeconds(), cpuInfo.GetUserPercent(), cpuInfo.GetSystemPercent(),
+				cpuInfo.GetIowaitPercent(), cpuInfo.GetStealPercent(), cpuInfo.GetIdlePercent())
+		}
+		fmt.Printf("内存使用率: %.2f%%\n", client.Info.GetMemoryInfo().GetMemoryUsagePercent())
+		fmt.Printf("总内存: %s\n", utils.FormatBytes(client.Info.GetMemoryInfo().GetTotalMemory()))
+		fmt.Printf("已用内存: %s\n", utils.FormatBytes(client.Info.GetMemoryInfo().GetUsedMemory()))
+		if memInfo := client.Info.GetMemoryInfo(); memInfo.GetAvailableMemory() > 0 {
+			fmt.Printf("  可用: %s | 空闲: %s | 缓冲区: %s | 缓存: %s | 共享: %s\n",
+				utils.FormatBytes(memInfo.GetAvailableMemory()), utils.FormatBytes(memInfo.GetFreeMemory()),
+				utils.FormatBytes(memInfo.GetBuffers()), utils.FormatBytes(memInfo.GetCached()),
+				utils.FormatBytes(memInfo.GetShared()))
+			fmt.Printf("  脏页: %s | 回写中: %s | Slab: %s (可回收 %s)\n",
+				utils.FormatBytes(memInfo.GetDirty()), utils.FormatBytes(memInfo.GetWriteback()),
+				utils.FormatBytes(memInfo.GetSlab()), utils.FormatBytes(memInfo.GetSlabReclaimable()))
+			if memInfo.GetHugepagesTotal() > 0 {
+				fmt.Printf("  大页: %d/%d 空闲, 每页 %s\n", memInfo.GetHugepagesFree(),
+					memInfo.GetHugepagesTotal(), utils.FormatBytes(memInfo.GetHugepageSize()))
+			}
+		}
+		if memInfo := client.Info.GetMemoryInfo(); memInfo.GetSwapTotal() > 0 {
+			fmt.Printf("交换分区: %s / %s\n",
+				utils.FormatBytes(memInfo.GetSwapUsed()), utils.FormatBytes(memInfo.GetSwapTotal()))
+		}
+		if pressure := client.Info.GetPressureInfo(); pressure != nil {
+			fmt.Printf("资源压力 (some/full, 10秒/60秒): CPU %.2f/%.2f | 内存 %.2f/%.2f, %.2f/%.2f | IO %.2f/%.2f, %.2f/%.2f\n",
+				pressure.GetCpu().GetSomeAvg10(), pressure.GetCpu().GetSomeAvg60(),
+				pressure.GetMemory().GetSomeAvg10(), pressure.GetMemory().GetSomeAvg60(),
+				pressure.GetMemory().GetFullAvg10(), pressure.GetMemory().GetFullAvg60(),
+				pressure.GetIo().GetSomeAvg10(), pressure.GetIo().GetSomeAvg60(),
+				pressure.GetIo().GetFullAvg10(), pressure.GetIo().GetFullAvg60())
+		}
+		fmt.Printf("磁盘分区数: %d\n", len(client.Info.GetDiskInfo().GetPartitions()))
+
+		for i, partition := range client.Info.GetDiskInfo().GetPartitions() {
+			fmt.Printf("  分区 %d: %s, 使用率: %.2f%%",
 				i+1, partition.MountPoint, partition.UsagePercent)
+			if partition.InodesTotal > 0 {
+				fmt.Printf(", inode使用率: %.2f%%", partition.InodesUsagePercent)
+			}
+			fmt.Println()
+		}
+
+		if devices := client.Info.GetDiskInfo().GetDevices(); len(devices) > 0 {
+			fmt.Printf("磁盘IO (最近 %.0f 秒):\n", client.Info.GetDiskInfo().GetSampleSeconds())
+			for _, device := range devices {
+				fmt.Printf("  %s: 读 %s / %.1f IOPS, 写 %s / %.1f IOPS, 平均耗时 %.2fms, 利用率 %.2f%%\n",
+					device.Name,
+					utils.FormatRate(device.ReadBytesPerSec), device.ReadsPerSec,
+					utils.FormatRate(device.WriteBytesPerSec), device.WritesPerSec,
+					device.AwaitMs, device.UtilizationPercent)
+			}
+		}
+
+		if interfaces := client.Info.GetNetworkInfo().GetInterfaces(); len(interfaces) > 0 {
+			names := make([]string, 0, len(interfaces))
+			for name := range interfaces {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			fmt.Printf("网络接口数: %d\n", len(interfaces))
+			for _, name := range names {
+				iface := interfaces[name]
+				fmt.Printf("  %s: IP %s, 状态: %v\n", name, iface.IpAddress, iface.IsUp)
+				if len(iface.IpAddresses) > 1 {
+					fmt.Printf("    所有地址: %s\n", models.FormatIPAddresses(iface.IpAddresses))
+				}
+				if iface.SampleSeconds > 0 {
+					fmt.Printf("    接收 %s / %.1f 包每秒, 发送 %s / %.1f 包每秒, 错误 %.1f/%.1f, 丢包 %.1f/%.1f (每秒)\n",
+						utils.FormatRate(iface.RxBytesPerSec), iface.RxPacketsPerSec,
+						utils.FormatRate(iface.TxBytesPerSec), iface.TxPacketsPerSec,
+						iface.RxErrorsPerSec, iface.TxErrorsPerSec, iface.RxDropsPerSec, iface.TxDropsPerSec)
+				}
+			}
+		}
+
+		if containers := client.Info.GetContainers(); len(containers) > 0 {
+			fmt.Printf("\n===== 容器和slice =====\n")
+			for _, container := range containers {
+				name := container.Cgroup
+				if container.Name != "" {
+					name = container.Name
+				}
+				fmt.Printf("  %s [%s]: CPU %.2f%%, 内存 %s, IO 读 %s / 写 %s, 进程 %d\n",
+					name, container.Runtime, container.CpuUsagePercent, utils.FormatBytes(container.MemoryUsage),
+					utils.FormatRate(container.IoReadBytesPerSec), utils.FormatRate(container.IoWriteBytesPerSec),
+					container.PidsCurrent)
+			}
+		}
+
+		if units := client.Info.GetServiceUnits(); len(units) > 0 {
+			fmt.Printf("\n===== systemd单元 =====\n")
+			for _, unit := range units {
+				fmt.Printf("  %s: %s/%s, 主进程: %d, 重启次数: %d\n",
+					unit.Name, unit.ActiveState, unit.SubState, unit.MainPid, unit.Restarts)
+			}
+		}
+
+		if listening := client.Info.GetConnectionInfo().GetListening(); len(listening) > 0 {
+			fmt.Printf("\n===== 监听端口 =====\n")
+			for _, socket := range listening {
+				fmt.Printf("  %s %s (%s)\n", socket.Protocol,
+					net.JoinHostPort(socket.Address, strconv.Itoa(int(socket.Port))), socket.Process)
+			}
+			fmt.Printf("  TCP连接: 已建立 %d, TIME_WAIT %d\n",
+				client.Info.ConnectionInfo.TcpStates["ESTABLISHED"], client.Info.ConnectionInfo.TcpStates["TIME_WAIT"])
+		}
+
+		if len(client.Info.CustomMetrics) > 0 {
+			fmt.Printf("\n===== 自定义指标 =====\n")
+			names := make([]string, 0, len(client.Info.CustomMetrics))
+			for name := range client.Info.CustomMetrics {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				fmt.Printf("  %s = %s\n", name, client.Info.CustomMetrics[name])
+			}
+		}
+
+		if len(client.Info.CheckResults) > 0 {
+			fmt.Printf("\n===== 检查 =====\n")
+			for _, check := range client.Info.CheckResults {
+				fmt.Printf("  [%s] %s: %s\n", models.CheckStateName(check.State), check.Name, check.Output)
+			}
+		}
+
+		if len(client.Info.CollectorErrors) > 0 {
+			fmt.Printf("\n===== 采集错误 =====\n")
+			for _, collectorErr := range client.Info.CollectorErrors {
+				fmt.Printf("  %s: %s (%s)\n", collectorErr.Collector, collectorErr.Error,
+					time.Unix(collectorErr.Timestamp, 0).Format(time.RFC3339))
+			}
 		}
 	}
 
@@ -244,7 +473,7 @@ func handleSendCommand(s ServerInterface) {
 
 	clientID := clients[idx].ID
 
-	cmdTypes := []string{"shell", "collect_info", "update"}
+	cmdTypes := []string{"shell", "collect_info", "update", "service"}
 	cmdTypePrompt := promptui.Select{
 		Label: "选择命令类型",
 		Items: cmdTypes,
@@ -325,6 +554,7 @@ func handleViewCommandResult(s ServerInterface) {
 	fmt.Printf("命令ID: %s\n", result.CommandId)
 	fmt.Printf("客户端ID: %s\n", result.ClientId)
 	fmt.Printf("执行状态: %v\n", result.Success)
+	fmt.Printf("退出码: %d\n", result.ExitCode)
 	fmt.Printf("执行时间: %d ms\n", result.ExecutionTimeMs)
 	fmt.Printf("完成时间: %s\n",
 		time.Unix(result.CompletedAt, 0).Format(time.RFC3339))
@@ -351,7 +581,24 @@ func getDefaultContentForCommand(cmdType string) string {
 		return "all"
 	case "update":
 		return "latest"
+	case "service":
+		return "status nginx.service"
 	default:
 		return ""
 	}
 }
+
+// formatLabels 将标签格式化为按键排序的 "k=v" 列表
+func formatLabels(labels map[string]string) string {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, len(keys))
+	for i, k := range keys {
+		parts[i] = k + "=" + labels[k]
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,39 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/manifoldco/promptui"
+)
+
+// handleSearchScheduledJobs 列出所有客户端上的cron任务和systemd定时器
+func handleSearchScheduledJobs(s ServerInterface) {
+	patternPrompt := promptui.Prompt{
+		Label: "命令、用户或文件（支持通配符，re: 开头为正则表达式，留空显示全部）",
+	}
+	pattern, err := patternPrompt.Run()
+	if err != nil {
+		fmt.Printf("输入错误: %v\n", err)
+		return
+	}
+
+	matches, err := s.SearchScheduledJobs(pattern)
+	if err != nil {
+		fmt.Printf("错误: %v\n", err)
+		return
+	}
+
+	fmt.Printf("\n===== 计划任务 =====\n")
+	if len(matches) == 0 {
+		fmt.Println("没有找到匹配的计划任务")
+		return
+	}
+
+	hosts := make(map[string]bool)
+	for _, match := range matches {
+		hosts[match.ClientID] = true
+		fmt.Printf("%-25s %-12s %-20s %-8s %s\n", match.Hostname, match.Job.Source,
+			match.Job.Schedule, match.Job.User, match.Job.Command)
+	}
+	fmt.Printf("共 %d 个计划任务，分布在 %d 台主机上\n", len(matches), len(hosts))
+}
@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"GoMonitor/pkg/models"
+
+	"github.com/manifoldco/promptui"
+)
+
+// handleViewIntegrity 显示客户端与基线不一致的文件及变化前后的状态
+func handleViewIntegrity(s ServerInterface) {
+	clients := s.ListClients()
+	if len(clients) == 0 {
+		fmt.Println("目前没有已连接的客户端")
+		return
+	}
+
+	clientIDs := make([]string, len(clients))
+	for i, client := range clients {
+		clientIDs[i] = fmt.Sprintf("%s (%s)", client.ID, client.Hostname)
+	}
+
+	selectPrompt := promptui.Select{
+		Label: "选择客户端",
+		Items: clientIDs,
+		Size:  10,
+	}
+
+	idx, _, err := selectPrompt.Run()
+	if err != nil {
+		fmt.Printf("选择错误: %v\n", err)
+		return
+	}
+
+	report, err := s.GetIntegrityReport(clients[idx].ID)
+	if err != nil {
+		fmt.Printf("错误: %v\n", err)
+		return
+	}
+
+	fmt.Printf("\n===== 文件完整性 =====\n")
+	if report == nil {
+		fmt.Println("尚未收到文件完整性监控结果（客户端可能未配置 integrity.paths）")
+		return
+	}
+
+	fmt.Printf("基线: %d 个文件，更新于 %s\n", report.FileCount, time.Unix(report.BaselinedAt, 0).Format(time.RFC3339))
+	if len(report.Changes) == 0 {
+		fmt.Println("所有文件与基线一致")
+		return
+	}
+	for _, change := range report.Changes {
+		fmt.Println(models.FormatFileChange(change))
+		if change.Before != nil && change.After != nil {
+			fmt.Printf("  之前: %s\n", models.FormatFileState(change.Before))
+			fmt.Printf("  现在: %s\n", models.FormatFileState(change.After))
+		}
+	}
+	fmt.Printf("共 %d 个文件与基线不一致\n", len(report.Changes))
+}
+
+// handleRebaseline 向客户端发送重建基线的命令，可以只接受指定路径下的变化
+func handleRebaseline(s ServerInterface) {
+	clients := s.ListClients()
+	if len(clients) == 0 {
+		fmt.Println("目前没有已连接的客户端")
+		return
+	}
+
+	clientIDs := make([]string, len(clients))
+	for i, client := range clients {
+		clientIDs[i] = fmt.Sprintf("%s (%s)", client.ID, client.Hostname)
+	}
+
+	selectPrompt := promptui.Select{
+		Label: "选择客户端",
+		Items: clientIDs,
+		Size:  10,
+	}
+
+	idx, _, err := selectPrompt.Run()
+	if err != nil {
+		fmt.Printf("选择错误: %v\n", err)
+		return
+	}
+
+	pathPrompt := promptui.Prompt{
+		Label: "只接受该路径下的变化（支持通配符，留空表示重建整个基线）",
+	}
+	path, err := pathPrompt.Run()
+	if err != nil {
+		fmt.Printf("输入错误: %v\n", err)
+		return
+	}
+
+	content := strings.TrimSpace("rebaseline " + strings.TrimSpace(path))
+	cmdID, err := s.SendCommandToClient(clients[idx].ID, "integrity", content, 60)
+	if err != nil {
+		fmt.Printf("发送命令失败: %v\n", err)
+		return
+	}
+	fmt.Printf("命令已发送，ID: %s，可在“查看命令执行结果”中查看\n", cmdID)
+}
@@ -0,0 +1,160 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"GoMonitor/pkg/models"
+	"GoMonitor/pkg/utils"
+	"GoMonitor/proto"
+	"github.com/manifoldco/promptui"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// inventoryExport 是导出文件中每个客户端的记录
+type inventoryExport struct {
+	ClientID  string          `json:"client_id"`
+	Hostname  string          `json:"hostname"`
+	IPAddress string          `json:"ip_address"`
+	UpdatedAt time.Time       `json:"updated_at"`
+	Inventory json.RawMessage `json:"inventory"`
+}
+
+// handleViewInventory 显示客户端的硬件和系统清单
+func handleViewInventory(s ServerInterface) {
+	clients := s.ListClients()
+	if len(clients) == 0 {
+		fmt.Println("目前没有已连接的客户端")
+		return
+	}
+
+	clientIDs := make([]string, len(clients))
+	for i, client := range clients {
+		clientIDs[i] = fmt.Sprintf("%s (%s)", client.ID, client.Hostname)
+	}
+
+	selectPrompt := promptui.Select{
+		Label: "选择客户端",
+		Items: clientIDs,
+		Size:  10,
+	}
+
+	idx, _, err := selectPrompt.Run()
+	if err != nil {
+		fmt.Printf("选择错误: %v\n", err)
+		return
+	}
+
+	client, err := s.GetClientInfo(clients[idx].ID)
+	if err != nil {
+		fmt.Printf("错误: %v\n", err)
+		return
+	}
+
+	inv := client.Inventory
+	if inv == nil {
+		fmt.Println("该客户端没有上报硬件和系统清单")
+		return
+	}
+
+	fmt.Printf("\n===== 硬件和系统清单 =====\n")
+	fmt.Printf("更新时间: %s\n", client.InventoryUpdatedAt.Format(time.RFC3339))
+	fmt.Printf("发行版: %s %s\n", inv.Distro, inv.DistroVersion)
+	fmt.Printf("内核: %s (%s)\n", inv.KernelVersion, inv.Architecture)
+	if inv.Virtualization != "" {
+		fmt.Printf("虚拟化: %s (%s)\n", inv.Virtualization, inv.VirtualizationRole)
+	}
+	if inv.BootTime > 0 {
+		fmt.Printf("启动时间: %s\n", time.Unix(inv.BootTime, 0).Format(time.RFC3339))
+	}
+	fmt.Printf("CPU: %s\n", models.FormatInventoryCPU(inv))
+	fmt.Printf("内存: %s\n", utils.FormatBytes(inv.TotalMemory))
+
+	if dmi := inv.Dmi; dmi != nil {
+		fmt.Printf("厂商: %s | 型号: %s | 序列号: %s\n", dmi.Vendor, dmi.Product, dmi.Serial)
+		fmt.Printf("主板: %s %s | BIOS: %s %s (%s)\n",
+			dmi.BoardVendor, dmi.BoardName, dmi.BiosVendor, dmi.BiosVersion, dmi.BiosDate)
+	}
+
+	fmt.Printf("磁盘:\n")
+	for _, disk := range inv.Disks {
+		kind := "SSD"
+		if disk.Rotational {
+			kind = "HDD"
+		}
+		if disk.Removable {
+			kind += ", 可移动"
+		}
+		fmt.Printf("  %s: %s, %s, 型号: %s, 序列号: %s\n",
+			disk.Name, utils.FormatBytes(disk.SizeBytes), kind, disk.Model, disk.Serial)
+	}
+
+	fmt.Printf("网卡:\n")
+	for _, nic := range inv.Nics {
+		fmt.Printf("  %s: %s, MTU %d, %d Mbps, 驱动: %s\n",
+			nic.Name, nic.MacAddress, nic.Mtu, nic.SpeedMbps, nic.Driver)
+	}
+}
+
+// handleExportInventory 将所有客户端的硬件和系统清单导出为JSON文件
+func handleExportInventory(s ServerInterface) {
+	pathPrompt := promptui.Prompt{
+		Label:   "导出文件路径",
+		Default: "inventory.json",
+	}
+	path, err := pathPrompt.Run()
+	if err != nil {
+		fmt.Printf("输入错误: %v\n", err)
+		return
+	}
+
+	data, count, err := exportInventory(s.ListClients())
+	if err != nil {
+		fmt.Printf("导出失败: %v\n", err)
+		return
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		fmt.Printf("导出失败: %v\n", err)
+		return
+	}
+	fmt.Printf("已导出 %d 个客户端的清单到 %s\n", count, path)
+}
+
+// exportInventory 将上报了清单的客户端按主机名排序后编码为JSON，清单字段名与协议定义一致
+func exportInventory(clients []*models.ClientInfo) ([]byte, int, error) {
+	marshaler := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}
+
+	records := make([]inventoryExport, 0, len(clients))
+	for _, client := range clients {
+		if client.Inventory == nil {
+			continue
+		}
+		inv, err := marshaler.Marshal(client.Inventory)
+		if err != nil {
+			return nil, 0, err
+		}
+		records = append(records, inventoryExport{
+			ClientID:  client.ID,
+			Hostname:  client.Hostname,
+			IPAddress: client.IPAddress,
+			UpdatedAt: client.InventoryUpdatedAt,
+			Inventory: inv,
+		})
+	}
+
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].Hostname < records[j].Hostname
+	})
+
+	data, err := json.MarshalIndent(records, "", "  ")
+	return data, len(records), err
+}
+
+// inventorySummary 返回清单的一行摘要，用于客户端详情
+func inventorySummary(inv *proto.HostInventory) string {
+	return fmt.Sprintf("%s %s, 内核 %s, %s, 内存 %s",
+		inv.Distro, inv.DistroVersion, inv.KernelVersion, models.FormatInventoryCPU(inv), utils.FormatBytes(inv.TotalMemory))
+}
@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"fmt"
+
+	"GoMonitor/pkg/models"
+
+	"github.com/manifoldco/promptui"
+)
+
+// handleViewLogins 显示客户端当前的登录会话、登录历史、成功的SSH登录和按来源统计的失败登录
+func handleViewLogins(s ServerInterface) {
+	clients := s.ListClients()
+	if len(clients) == 0 {
+		fmt.Println("目前没有已连接的客户端")
+		return
+	}
+
+	clientIDs := make([]string, len(clients))
+	for i, client := range clients {
+		clientIDs[i] = fmt.Sprintf("%s (%s)", client.ID, client.Hostname)
+	}
+
+	selectPrompt := promptui.Select{
+		Label: "选择客户端",
+		Items: clientIDs,
+		Size:  10,
+	}
+
+	idx, _, err := selectPrompt.Run()
+	if err != nil {
+		fmt.Printf("选择错误: %v\n", err)
+		return
+	}
+
+	logins, err := s.GetLogins(clients[idx].ID)
+	if err != nil {
+		fmt.Printf("错误: %v\n", err)
+		return
+	}
+
+	fmt.Printf("\n===== 登录会话 =====\n")
+	fmt.Print(models.FormatLoginInfo(logins))
+
+	fmt.Println("\n按Enter键继续...")
+	fmt.Scanln()
+}
@@ -0,0 +1,136 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"GoMonitor/pkg/models"
+
+	"github.com/manifoldco/promptui"
+)
+
+const (
+	// searchLogLimit 是搜索日志时最多显示的行数（最新的）
+	searchLogLimit = 200
+	// followLogBacklog 是开始实时查看时先显示的最近行数
+	followLogBacklog = 20
+	// followLogInterval 是实时查看时拉取新日志的间隔
+	followLogInterval = time.Second
+)
+
+// handleSearchLogs 按客户端、日志源和正则表达式搜索客户端转发的日志
+func handleSearchLogs(s ServerInterface) {
+	query, ok := promptLogQuery(s)
+	if !ok {
+		return
+	}
+	query.Limit = searchLogLimit
+
+	entries, err := s.SearchLogs(query)
+	if err != nil {
+		fmt.Printf("错误: %v\n", err)
+		return
+	}
+
+	fmt.Printf("\n===== 日志 =====\n")
+	if len(entries) == 0 {
+		fmt.Println("没有匹配的日志")
+	}
+	for _, entry := range entries {
+		fmt.Println(models.FormatLogEntry(entry))
+	}
+	if len(entries) == searchLogLimit {
+		fmt.Printf("只显示最新的 %d 行\n", searchLogLimit)
+	}
+
+	fmt.Println("\n按Enter键继续...")
+	fmt.Scanln()
+}
+
+// handleFollowLogs 持续显示客户端新转发的日志，按Enter键停止
+func handleFollowLogs(s ServerInterface) {
+	query, ok := promptLogQuery(s)
+	if !ok {
+		return
+	}
+	query.Limit = followLogBacklog
+
+	stop := make(chan struct{})
+	go func() {
+		fmt.Scanln()
+		close(stop)
+	}()
+
+	fmt.Printf("\n===== 实时日志（按Enter键停止）=====\n")
+	ticker := time.NewTicker(followLogInterval)
+	defer ticker.Stop()
+
+	for {
+		entries, err := s.SearchLogs(query)
+		if err != nil {
+			fmt.Printf("错误: %v\n", err)
+			<-stop
+			return
+		}
+		for _, entry := range entries {
+			fmt.Println(models.FormatLogEntry(entry))
+			query.AfterSeq = entry.Seq
+		}
+		query.Limit = 0
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// promptLogQuery 询问要查看的客户端、日志源和内容表达式
+func promptLogQuery(s ServerInterface) (models.LogQuery, bool) {
+	var query models.LogQuery
+
+	clients := s.ListClients()
+	items := make([]string, len(clients)+1)
+	items[0] = "所有客户端"
+	for i, client := range clients {
+		items[i+1] = fmt.Sprintf("%s (%s)", client.ID, client.Hostname)
+	}
+
+	selectPrompt := promptui.Select{
+		Label: "选择客户端",
+		Items: items,
+		Size:  10,
+	}
+	idx, _, err := selectPrompt.Run()
+	if err != nil {
+		fmt.Printf("选择错误: %v\n", err)
+		return query, false
+	}
+	if idx > 0 {
+		query.ClientID = clients[idx-1].ID
+	}
+
+	sourcePrompt := promptui.Prompt{
+		Label: "日志源（通配符或 re: 开头的正则表达式，例如 /var/log/*、journald:sshd*，留空表示全部）",
+	}
+	source, err := sourcePrompt.Run()
+	if err != nil {
+		fmt.Printf("输入错误: %v\n", err)
+		return query, false
+	}
+	query.Source = strings.TrimSpace(source)
+
+	patternPrompt := promptui.Prompt{
+		Label: "匹配内容的正则表达式（留空表示全部）",
+	}
+	pattern, err := patternPrompt.Run()
+	if err != nil {
+		fmt.Printf("输入错误: %v\n", err)
+		return query, false
+	}
+	query.Pattern = strings.TrimSpace(pattern)
+
+	return query, true
+}
@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/manifoldco/promptui"
+)
+
+// handleSearchPackages 在所有客户端中搜索软件包，例如查找仍在使用 openssl 1.1 的主机
+func handleSearchPackages(s ServerInterface) {
+	namePrompt := promptui.Prompt{
+		Label: "包名（支持通配符，re: 开头为正则表达式）",
+	}
+	name, err := namePrompt.Run()
+	if err != nil {
+		fmt.Printf("输入错误: %v\n", err)
+		return
+	}
+
+	versionPrompt := promptui.Prompt{
+		Label: "版本前缀（留空表示不限）",
+	}
+	versionPrefix, err := versionPrompt.Run()
+	if err != nil {
+		fmt.Printf("输入错误: %v\n", err)
+		return
+	}
+
+	matches, err := s.SearchPackages(name, versionPrefix)
+	if err != nil {
+		fmt.Printf("错误: %v\n", err)
+		return
+	}
+
+	fmt.Printf("\n===== 搜索结果 =====\n")
+	if len(matches) == 0 {
+		fmt.Println("没有找到匹配的软件包")
+		return
+	}
+
+	hosts := make(map[string]bool)
+	for _, match := range matches {
+		hosts[match.ClientID] = true
+		fmt.Printf("%-25s %-5s %s %s %s\n", match.Hostname, match.Package.Manager,
+			match.Package.Name, match.Package.Version, match.Package.Arch)
+	}
+	fmt.Printf("共 %d 个软件包，分布在 %d 台主机上\n", len(matches), len(hosts))
+}
+
+// handleViewPackageHistory 显示客户端的软件包安装、卸载和版本变化历史
+func handleViewPackageHistory(s ServerInterface) {
+	clients := s.ListClients()
+	if len(clients) == 0 {
+		fmt.Println("目前没有已连接的客户端")
+		return
+	}
+
+	clientIDs := make([]string, len(clients))
+	for i, client := range clients {
+		clientIDs[i] = fmt.Sprintf("%s (%s)", client.ID, client.Hostname)
+	}
+
+	selectPrompt := promptui.Select{
+		Label: "选择客户端",
+		Items: clientIDs,
+		Size:  10,
+	}
+
+	idx, _, err := selectPrompt.Run()
+	if err != nil {
+		fmt.Printf("选择错误: %v\n", err)
+		return
+	}
+
+	history, err := s.GetPackageHistory(clients[idx].ID)
+	if err != nil {
+		fmt.Printf("错误: %v\n", err)
+		return
+	}
+
+	fmt.Printf("\n===== 软件包变化历史 =====\n")
+	if len(history) == 0 {
+		fmt.Println("暂无软件包变化")
+		return
+	}
+	for _, change := range history {
+		switch change.Action() {
+		case "installed":
+			fmt.Printf("%s 安装 %s/%s %s\n", change.Timestamp.Format(time.RFC3339), change.Manager, change.Name, change.NewVersion)
+		case "removed":
+			fmt.Printf("%s 卸载 %s/%s %s\n", change.Timestamp.Format(time.RFC3339), change.Manager, change.Name, change.OldVersion)
+		default:
+			fmt.Printf("%s 更新 %s/%s %s -> %s\n", change.Timestamp.Format(time.RFC3339), change.Manager, change.Name, change.OldVersion, change.NewVersion)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/manifoldco/promptui"
+)
+
+// handleViewVulnerabilities 显示客户端已安装软件包命中的安全公告
+func handleViewVulnerabilities(s ServerInterface) {
+	clients := s.ListClients()
+	if len(clients) == 0 {
+		fmt.Println("目前没有已连接的客户端")
+		return
+	}
+
+	clientIDs := make([]string, len(clients))
+	for i, client := range clients {
+		clientIDs[i] = fmt.Sprintf("%s (%s)", client.ID, client.Hostname)
+	}
+
+	selectPrompt := promptui.Select{
+		Label: "选择客户端",
+		Items: clientIDs,
+		Size:  10,
+	}
+
+	idx, _, err := selectPrompt.Run()
+	if err != nil {
+		fmt.Printf("选择错误: %v\n", err)
+		return
+	}
+
+	findings, err := s.GetVulnerabilities(clients[idx].ID)
+	if err != nil {
+		fmt.Printf("错误: %v\n", err)
+		return
+	}
+
+	fmt.Printf("\n===== 主机漏洞 =====\n")
+	if len(findings) == 0 {
+		fmt.Println("没有命中的安全公告（或尚未收到软件包清单）")
+		return
+	}
+	for _, finding := range findings {
+		fixed := finding.FixedVersion
+		if fixed == "" {
+			fixed = "暂无"
+		}
+		fmt.Printf("[%s] %s %s\n", finding.Severity, finding.AdvisoryID, finding.Summary)
+		if len(finding.Aliases) > 0 {
+			fmt.Printf("  别名: %s\n", strings.Join(finding.Aliases, ", "))
+		}
+		fmt.Printf("  软件包: %s/%s %s，修复版本: %s\n",
+			finding.Package.Manager, finding.Package.Name, finding.Package.Version, fixed)
+	}
+	fmt.Printf("共 %d 条\n", len(findings))
+}
+
+// handleViewVulnerabilityReport 显示所有客户端的漏洞汇总，按严重级别和受影响主机数排序
+func handleViewVulnerabilityReport(s ServerInterface) {
+	report := s.GetVulnerabilityReport()
+
+	fmt.Printf("\n===== 漏洞汇总（已加载 %d 条公告） =====\n", report.AdvisoryCount)
+	if len(report.Impacts) == 0 {
+		fmt.Println("没有主机命中安全公告")
+		return
+	}
+
+	hosts := make(map[string]bool)
+	for _, impact := range report.Impacts {
+		fmt.Printf("[%s] %s %s\n", impact.Severity, impact.AdvisoryID, impact.Summary)
+		fmt.Printf("  主机(%d): %s\n", len(impact.Hosts), strings.Join(impact.Hosts, ", "))
+		fmt.Printf("  软件包: %s\n", strings.Join(impact.Packages, ", "))
+		for _, host := range impact.Hosts {
+			hosts[host] = true
+		}
+	}
+	fmt.Printf("共 %d 条公告，影响 %d 台主机\n", len(report.Impacts), len(hosts))
+}
@@ -2,13 +2,30 @@ package main
 
 import (
 	"fmt"
+	"net"
+	"strings"
 	"time"
 
 	"GoMonitor/pkg/models"
 	"GoMonitor/proto"
 	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
 )
 
+// clientStaleAfter 是客户端记录被视为过期的未上报时长
+const clientStaleAfter = 3 * time.Minute
+
+// clientIdentity 是注册和每次上报时携带的客户端身份信息
+type clientIdentity struct {
+	Hostname    string
+	IPAddress   string
+	IPAddresses []*proto.IPAddress
+	MACAddress  string
+	OSInfo      string
+}
+
 // ClientManager 处理客户端管理相关功能
 type ClientManager struct {
 	server *Server
@@ -21,32 +38,152 @@ func NewClientManager(server *Server) *ClientManager {
 	}
 }
 
-// RegisterClient 注册一个新客户端
-func (cm *ClientManager) RegisterClient(req *proto.RegisterRequest) (string, error) {
-	clientID := uuid.New().String()
+// RegisterClient 注册客户端，observed为服务端观测到的对端地址
+// 客户端重新注册时会携带旧ID并沿用，使服务端重启后客户端标识保持不变；
+// 服务端仍记得该客户端时，只有记录已过期或主机名和MAC地址与记录一致才原地更新，身份信息的变化记录为事件，
+// 否则该ID可能被另一台主机（例如复制了状态的克隆机）使用，分配新ID，避免覆盖其他客户端的信息
+func (cm *ClientManager) RegisterClient(req *proto.RegisterRequest, observed string) (string, error) {
+	clientID := req.ClientId
+	client, exists := cm.server.clients[clientID]
+	if clientID == "" || (exists && !reusable(client, req)) {
+		clientID = uuid.New().String()
+		exists = false
+	}
 
-	cm.server.clients[clientID] = &models.ClientInfo{
-		ID:         clientID,
-		Hostname:   req.Hostname,
-		IPAddress:  req.IpAddress,
-		MACAddress: req.MacAddress,
-		OSInfo:     req.OsInfo,
-		LastSeen:   time.Now(),
+	identity := clientIdentity{
+		Hostname:    req.Hostname,
+		IPAddress:   req.IpAddress,
+		IPAddresses: req.IpAddresses,
+		MACAddress:  req.MacAddress,
+		OSInfo:      req.OsInfo,
+	}
+	if exists {
+		cm.updateIdentity(client, identity)
+	} else {
+		client = &models.ClientInfo{ID: clientID}
+		cm.server.clients[clientID] = client
+		setIdentity(client, identity)
 	}
 
-	cm.server.cmdManager.InitClientCommands(clientID)
+	client.Labels = req.Labels
+	client.LastSeen = time.Now()
+	cm.ObservePeer(clientID, observed)
+	cm.UpdateInventory(clientID, req.Inventory)
+
+	if _, exists := cm.server.cmdManager.pendingCmds[clientID]; !exists {
+		cm.server.cmdManager.InitClientCommands(clientID)
+	}
 
 	return clientID, nil
 }
 
-// UpdateClientInfo 更新客户端的系统信息
-func (cm *ClientManager) UpdateClientInfo(clientID string, info *proto.SystemInfo) error {
+// reusable 判断已有的客户端记录能否被携带同一ID的注册沿用：记录已过期或注册的主机名和MAC地址与记录一致
+// 不以命令流是否断开判断，否则两台使用同一ID的主机交替重连时会互相覆盖
+func reusable(client *models.ClientInfo, req *proto.RegisterRequest) bool {
+	if time.Since(client.LastSeen) > clientStaleAfter {
+		return true
+	}
+	return client.Hostname == req.Hostname && client.MACAddress == req.MacAddress
+}
+
+// updateIdentity 比较客户端前后的身份信息，记录发生变化的字段后保存新的身份信息
+func (cm *ClientManager) updateIdentity(client *models.ClientInfo, identity clientIdentity) {
+	changes := []struct {
+		field    string
+		old, new string
+	}{
+		{"主机名", client.Hostname, identity.Hostname},
+		{"IP地址", client.IPAddress, identity.IPAddress},
+		{"MAC地址", client.MACAddress, identity.MACAddress},
+		{"操作系统", client.OSInfo, identity.OSInfo},
+	}
+
+	for _, change := range changes {
+		if change.old != change.new {
+			cm.server.eventManager.Record(client.ID, "client_changed", models.SeverityWarning,
+				fmt.Sprintf("%s从 %s 变为 %s", change.field, change.old, change.new))
+		}
+	}
+
+	if added, removed := models.DiffIPAddresses(client.IPAddresses, identity.IPAddresses); len(added)+len(removed) > 0 {
+		var parts []string
+		if len(added) > 0 {
+			parts = append(parts, "新增 "+strings.Join(added, ", "))
+		}
+		if len(removed) > 0 {
+			parts = append(parts, "移除 "+strings.Join(removed, ", "))
+		}
+		cm.server.eventManager.Record(client.ID, "client_changed", models.SeverityWarning,
+			"地址列表变化: "+strings.Join(parts, "; "))
+	}
+
+	setIdentity(client, identity)
+}
+
+// setIdentity 保存客户端的身份信息
+func setIdentity(client *models.ClientInfo, identity clientIdentity) {
+	client.Hostname = identity.Hostname
+	client.IPAddress = identity.IPAddress
+	client.IPAddresses = identity.IPAddresses
+	client.MACAddress = identity.MACAddress
+	client.OSInfo = identity.OSInfo
+}
+
+// ObservePeer 记录服务端观测到的客户端地址，地址变化时记录事件
+// 未知客户端或无法获取对端地址时忽略
+func (cm *ClientManager) ObservePeer(clientID string, observed string) {
+	client, exists := cm.server.clients[clientID]
+	if !exists || observed == "" {
+		return
+	}
+
+	if client.ObservedAddress != "" && client.ObservedAddress != observed {
+		cm.server.eventManager.Record(clientID, "address_changed", models.SeverityInfo,
+			fmt.Sprintf("观测到的地址从 %s 变为 %s", client.ObservedAddress, observed))
+	}
+	client.ObservedAddress = observed
+	client.ObservedAt = time.Now()
+}
+
+// UpdateInventory 保存客户端上报的硬件和系统清单，与已保存的清单不同时记录变化事件
+// inventory为nil（客户端本次未携带清单）时忽略
+func (cm *ClientManager) UpdateInventory(clientID string, inventory *proto.HostInventory) {
+	client, exists := cm.server.clients[clientID]
+	if !exists || inventory == nil {
+		return
+	}
+
+	if protobuf.Equal(client.Inventory, inventory) {
+		return
+	}
+	if client.Inventory != nil {
+		if changes := models.InventoryChanges(client.Inventory, inventory); len(changes) > 0 {
+			cm.server.eventManager.Record(clientID, "inventory_changed", models.SeverityInfo, strings.Join(changes, "; "))
+		}
+	}
+
+	client.Inventory = inventory
+	client.InventoryUpdatedAt = time.Now()
+}
+
+// UpdateClientInfo 更新客户端的系统信息，并与已记录的身份信息比较
+// 旧版本客户端的上报不携带身份信息（主机名为空），此时不比较
+func (cm *ClientManager) UpdateClientInfo(clientID string, req *proto.SystemInfoRequest) error {
 	client, exists := cm.server.clients[clientID]
 	if !exists {
-		return fmt.Errorf("未知的客户端ID: %s", clientID)
+		return errUnknownClient(clientID)
 	}
 
-	client.Info = info
+	if req.Hostname != "" {
+		cm.updateIdentity(client, clientIdentity{
+			Hostname:    req.Hostname,
+			IPAddress:   req.IpAddress,
+			IPAddresses: req.IpAddresses,
+			MACAddress:  req.MacAddress,
+			OSInfo:      req.OsInfo,
+		})
+	}
+	client.Info = req.SystemInfo
 	client.LastSeen = time.Now()
 
 	return nil
@@ -56,11 +193,16 @@ func (cm *ClientManager) UpdateClientInfo(clientID string, info *proto.SystemInf
 func (cm *ClientManager) ValidateClient(clientID string) error {
 	_, exists := cm.server.clients[clientID]
 	if !exists {
-		return fmt.Errorf("未知的客户端ID: %s", clientID)
+		return errUnknownClient(clientID)
 	}
 	return nil
 }
 
+// errUnknownClient 返回未知客户端错误，使用NotFound状态码以便客户端识别后重新注册
+func errUnknownClient(clientID string) error {
+	return status.Errorf(codes.NotFound, "未知的客户端ID: %s", clientID)
+}
+
 // GetClientInfo 获取客户端信息
 func (cm *ClientManager) GetClientInfo(clientID string) (*models.ClientInfo, error) {
 	client, exists := cm.server.clients[clientID]
@@ -92,6 +234,25 @@ func (cm *ClientManager) RemoveClient(clientID string) error {
 
 	cm.server.cmdManager.RemoveClientCommands(clientID)
 
+	cm.server.agentCfgMgr.RemoveClient(clientID)
+
+	cm.server.checkManager.RemoveClient(clientID)
+
+	cm.server.ruleManager.RemoveClient(clientID)
+
+	cm.server.portManager.RemoveClient(clientID)
+
+	cm.server.pkgManager.RemoveClient(clientID)
+	cm.server.advisoryMgr.RemoveClient(clientID)
+	cm.server.integrityMgr.RemoveClient(clientID)
+	cm.server.logManager.RemoveClient(clientID)
+	cm.server.logRuleMgr.RemoveClient(clientID)
+	cm.server.loginManager.RemoveClient(clientID)
+	cm.server.accountMgr.RemoveClient(clientID)
+	cm.server.cronManager.RemoveClient(clientID)
+
+	cm.server.alertManager.ResolveClient(clientID)
+
 	return nil
 }
 
@@ -105,10 +266,15 @@ func (cm *ClientManager) GetClientByHostname(hostname string) (*models.ClientInf
 	return nil, fmt.Errorf("找不到主机名为 %s 的客户端", hostname)
 }
 
-// GetClientByIP 根据IP地址查找客户端
+// GetClientByIP 根据IP地址查找客户端，匹配客户端的任一地址（IPv4或IPv6）
 func (cm *ClientManager) GetClientByIP(ipAddress string) (*models.ClientInfo, error) {
+	ip := net.ParseIP(ipAddress)
+	if ip == nil {
+		return nil, fmt.Errorf("无效的IP地址: %s", ipAddress)
+	}
+
 	for _, client := range cm.server.clients {
-		if client.IPAddress == ipAddress {
+		if ip.Equal(net.ParseIP(client.IPAddress)) || models.HasIPAddress(client.IPAddresses, ip) {
 			return client, nil
 		}
 	}
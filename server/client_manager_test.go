@@ -0,0 +1,114 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"GoMonitor/pkg/config"
+	"GoMonitor/proto"
+)
+
+func registerRequest(clientID string, hostname string) *proto.RegisterRequest {
+	return &proto.RegisterRequest{
+		ClientId:    clientID,
+		Hostname:    hostname,
+		IpAddress:   "10.0.0.1",
+		IpAddresses: []*proto.IPAddress{{Address: "10.0.0.1", PrefixLength: 24}},
+		OsInfo:      "debian 12 (linux)",
+	}
+}
+
+func TestRegisterClientReusesID(t *testing.T) {
+	tests := []struct {
+		name     string
+		hostname string
+		mac      string
+		lastSeen time.Duration
+		reuse    bool
+	}{
+		{"同一主机", "web-1", "52:54:00:00:00:01", 0, true},
+		{"记录已过期", "web-2", "52:54:00:00:00:02", clientStaleAfter + time.Minute, true},
+		{"主机名不同", "web-2", "52:54:00:00:00:01", 0, false},
+		{"MAC地址不同", "web-1", "52:54:00:00:00:02", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewServer(config.DefaultServerConfig())
+			req := registerRequest("", "web-1")
+			req.MacAddress = "52:54:00:00:00:01"
+			id, err := s.clientManager.RegisterClient(req, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			s.clients[id].LastSeen = time.Now().Add(-tt.lastSeen)
+
+			// 命令流是否在线不影响判断
+			req = registerRequest(id, tt.hostname)
+			req.MacAddress = tt.mac
+			got, err := s.clientManager.RegisterClient(req, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (got == id) != tt.reuse {
+				t.Fatalf("沿用ID = %v, 期望 %v", got == id, tt.reuse)
+			}
+			if !tt.reuse && s.clients[id].Hostname != "web-1" {
+				t.Fatalf("原客户端的主机名被覆盖为 %s", s.clients[id].Hostname)
+			}
+		})
+	}
+}
+
+func TestUpdateClientInfoRecordsIdentityChanges(t *testing.T) {
+	s := NewServer(config.DefaultServerConfig())
+	id, err := s.clientManager.RegisterClient(registerRequest("", "web-1"), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 地址顺序变化和IPv6的不同写法不算变化
+	s.clients[id].IPAddresses = append(s.clients[id].IPAddresses, &proto.IPAddress{Address: "2001:db8:0::1", PrefixLength: 64})
+	report := &proto.SystemInfoRequest{
+		ClientId:  id,
+		Hostname:  "web-1",
+		IpAddress: "10.0.0.1",
+		IpAddresses: []*proto.IPAddress{
+			{Address: "2001:db8::1", PrefixLength: 64},
+			{Address: "10.0.0.1", PrefixLength: 24},
+		},
+		OsInfo: "debian 12 (linux)",
+	}
+	if err := s.clientManager.UpdateClientInfo(id, report); err != nil {
+		t.Fatal(err)
+	}
+	if events := s.eventManager.List(id, 0); len(events) != 0 {
+		t.Fatalf("不应记录事件: %v", events[0].Message)
+	}
+
+	report.Hostname = "web-2"
+	report.IpAddresses = report.IpAddresses[1:]
+	if err := s.clientManager.UpdateClientInfo(id, report); err != nil {
+		t.Fatal(err)
+	}
+	var messages []string
+	for _, event := range s.eventManager.List(id, 0) {
+		messages = append(messages, event.Message)
+	}
+	joined := strings.Join(messages, "\n")
+	if !strings.Contains(joined, "主机名从 web-1 变为 web-2") || !strings.Contains(joined, "移除 2001:db8::1/64") {
+		t.Fatalf("事件不完整:\n%s", joined)
+	}
+	if s.clients[id].Hostname != "web-2" {
+		t.Fatalf("主机名未更新: %s", s.clients[id].Hostname)
+	}
+
+	// 不携带身份信息的上报（旧版本客户端）不比较
+	if err := s.clientManager.UpdateClientInfo(id, &proto.SystemInfoRequest{ClientId: id}); err != nil {
+		t.Fatal(err)
+	}
+	if s.clients[id].Hostname != "web-2" {
+		t.Fatalf("主机名被清空")
+	}
+}
@@ -13,6 +13,7 @@ type CommandManager struct {
 	server      *Server
 	pendingCmds map[string]map[string]*proto.Command // client_id -> command_id -> command
 	cmdResults  map[string]*proto.CommandResult      // command_id -> result
+	resultOrder []string                             // 按保存顺序排列的command_id，用于淘汰旧结果
 }
 
 // NewCommandManager 创建命令管理器
@@ -29,8 +30,8 @@ func (cm *CommandManager) InitClientCommands(clientID string) {
 	cm.pendingCmds[clientID] = make(map[string]*proto.Command)
 }
 
-// SaveCommandResult 保存命令执行结果
-func (cm *CommandManager) SaveCommandResult(clientID string, cmdID string, result *proto.CommandResult) error {
+// SaveCommandResult 保存命令执行结果，超过maxResults时淘汰最早的结果
+func (cm *CommandManager) SaveCommandResult(clientID string, cmdID string, result *proto.CommandResult, maxResults int) error {
 	clientCmds, exists := cm.pendingCmds[clientID]
 	if !exists || clientCmds == nil {
 		return fmt.Errorf("客户端没有待处理的命令")
@@ -42,12 +43,22 @@ func (cm *CommandManager) SaveCommandResult(clientID string, cmdID string, resul
 	}
 
 	cm.cmdResults[cmdID] = result
+	cm.resultOrder = append(cm.resultOrder, cmdID)
+	cm.TrimCommandResults(maxResults)
 
 	delete(clientCmds, cmdID)
 
 	return nil
 }
 
+// TrimCommandResults 只保留最近的maxResults条命令结果
+func (cm *CommandManager) TrimCommandResults(maxResults int) {
+	for len(cm.resultOrder) > maxResults {
+		delete(cm.cmdResults, cm.resultOrder[0])
+		cm.resultOrder = cm.resultOrder[1:]
+	}
+}
+
 // GetPendingCommands 获取客户端的待处理命令
 func (cm *CommandManager) GetPendingCommands(clientID string) []*proto.Command {
 	clientCmds, exists := cm.pendingCmds[clientID]
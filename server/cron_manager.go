@@ -0,0 +1,96 @@
+package main
+
+import (
+	"sort"
+
+	"GoMonitor/pkg/models"
+	"GoMonitor/pkg/utils"
+	"GoMonitor/proto"
+)
+
+// CronManager 保存各客户端最近一次上报的计划任务清单，并记录任务的增加和删除
+// 任务的任何属性变化都表现为删除旧任务、增加新任务
+type CronManager struct {
+	server *Server
+	jobs   map[string]*proto.ScheduledJobInventory // client_id -> 最近一次的计划任务清单
+}
+
+// NewCronManager 创建计划任务管理器
+func NewCronManager(server *Server) *CronManager {
+	return &CronManager{
+		server: server,
+		jobs:   make(map[string]*proto.ScheduledJobInventory),
+	}
+}
+
+// Update 处理客户端上报的计划任务清单，本次未上报时保持原状态
+// 首次收到某个客户端的清单时只作为基线，不产生事件
+func (cm *CronManager) Update(clientID string, inventory *proto.ScheduledJobInventory) {
+	if inventory == nil {
+		return
+	}
+
+	previous, known := cm.jobs[clientID]
+	cm.jobs[clientID] = inventory
+	if !known {
+		return
+	}
+
+	before := scheduledJobKeys(previous)
+	after := scheduledJobKeys(inventory)
+	for _, job := range inventory.GetJobs() {
+		if !before[models.ScheduledJobKey(job)] {
+			cm.server.eventManager.Record(clientID, "cron_job_added", models.SeverityInfo,
+				"新增计划任务: "+models.FormatScheduledJob(job))
+		}
+	}
+	for _, job := range previous.GetJobs() {
+		if !after[models.ScheduledJobKey(job)] {
+			cm.server.eventManager.Record(clientID, "cron_job_removed", models.SeverityInfo,
+				"计划任务已删除: "+models.FormatScheduledJob(job))
+		}
+	}
+}
+
+// scheduledJobKeys 返回清单中所有任务的标识
+func scheduledJobKeys(inventory *proto.ScheduledJobInventory) map[string]bool {
+	keys := make(map[string]bool, len(inventory.GetJobs()))
+	for _, job := range inventory.GetJobs() {
+		keys[models.ScheduledJobKey(job)] = true
+	}
+	return keys
+}
+
+// Search 列出所有客户端上命令、用户或文件匹配的计划任务，按主机名排序，同一主机保持上报顺序
+func (cm *CronManager) Search(pattern *utils.Matcher) []*models.ScheduledJobMatch {
+	var matches []*models.ScheduledJobMatch
+	for clientID, inventory := range cm.jobs {
+		client, exists := cm.server.clients[clientID]
+		if !exists {
+			continue
+		}
+		for _, job := range inventory.GetJobs() {
+			if !pattern.Match(job.Command) && !pattern.Match(job.User) && !pattern.Match(job.File) {
+				continue
+			}
+			matches = append(matches, &models.ScheduledJobMatch{
+				ClientID: clientID,
+				Hostname: client.Hostname,
+				Job:      job,
+			})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].Hostname != matches[j].Hostname {
+			return matches[i].Hostname < matches[j].Hostname
+		}
+		return matches[i].ClientID < matches[j].ClientID
+	})
+	return matches
+}
+
+// RemoveClient 清除客户端的计划任务清单
+func (cm *CronManager) RemoveClient(clientID string) {
+	delete(cm.jobs, clientID)
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"log"
+	"time"
+
+	"GoMonitor/pkg/models"
+	"github.com/google/uuid"
+)
+
+// EventManager 记录客户端上发生的各类状态变化事件
+type EventManager struct {
+	server *Server
+	events []*models.Event // 按时间顺序排列
+}
+
+// NewEventManager 创建事件管理器
+func NewEventManager(server *Server) *EventManager {
+	return &EventManager{
+		server: server,
+	}
+}
+
+// Record 记录一个事件，超过保留数量时淘汰最早的事件
+func (em *EventManager) Record(clientID string, eventType string, severity string, message string) *models.Event {
+	event := &models.Event{
+		ID:        uuid.New().String(),
+		ClientID:  clientID,
+		Type:      eventType,
+		Severity:  severity,
+		Message:   message,
+		Timestamp: time.Now(),
+	}
+
+	if client, exists := em.server.clients[clientID]; exists {
+		event.Hostname = client.Hostname
+	}
+
+	em.events = append(em.events, event)
+	if overflow := len(em.events) - em.server.cfg.Storage.MaxEvents; overflow > 0 {
+		em.events = append([]*models.Event(nil), em.events[overflow:]...)
+	}
+
+	log.Printf("事件[%s/%s] 客户端 %s (%s): %s", eventType, severity, clientID, event.Hostname, message)
+	return event
+}
+
+// List 返回最近的事件，最新的在前；clientID为空时返回所有客户端的事件，limit<=0表示不限制
+func (em *EventManager) List(clientID string, limit int) []*models.Event {
+	events := []*models.Event{}
+	for i := len(em.events) - 1; i >= 0; i-- {
+		if clientID != "" && em.events[i].ClientID != clientID {
+			continue
+		}
+		events = append(events, em.events[i])
+		if limit > 0 && len(events) >= limit {
+			break
+		}
+	}
+	return events
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"fmt"
+
+	"GoMonitor/pkg/models"
+	"GoMonitor/proto"
+)
+
+// integrityAlertKey 是文件完整性告警的标识，每个客户端一个告警
+const integrityAlertKey = "integrity"
+
+// maxIntegrityAlertDetails 是告警附加信息中最多列出的文件数
+const maxIntegrityAlertDetails = 20
+
+// IntegrityManager 保存各客户端最近一次的文件完整性监控结果
+// 文件出现新的变化时记录事件；存在与基线不一致的文件时保持告警，重建基线后自动恢复
+type IntegrityManager struct {
+	server  *Server
+	reports map[string]*proto.IntegrityReport // client_id -> 最近一次的结果
+}
+
+// NewIntegrityManager 创建文件完整性管理器
+func NewIntegrityManager(server *Server) *IntegrityManager {
+	return &IntegrityManager{
+		server:  server,
+		reports: make(map[string]*proto.IntegrityReport),
+	}
+}
+
+// Update 处理客户端上报的文件完整性监控结果，本次未上报（未配置或采集失败）时保持原状态
+// 客户端每次上报所有与基线不一致的文件，只有新出现的变化或同一文件再次变化时才记录事件
+func (im *IntegrityManager) Update(clientID string, report *proto.IntegrityReport) {
+	if report == nil {
+		return
+	}
+
+	previous := im.reports[clientID]
+	im.reports[clientID] = report
+
+	known := make(map[string]*proto.FileChange, len(previous.GetChanges()))
+	for _, change := range previous.GetChanges() {
+		known[change.Path] = change
+	}
+
+	for _, change := range report.Changes {
+		old, ok := known[change.Path]
+		if ok && old.Action == change.Action && models.SameFileState(old.After, change.After) {
+			continue
+		}
+		im.server.eventManager.Record(clientID, "file_"+change.Action, models.SeverityWarning, models.FormatFileChange(change))
+	}
+
+	if previous != nil && report.BaselinedAt != previous.BaselinedAt {
+		im.server.eventManager.Record(clientID, "integrity_rebaselined", models.SeverityInfo,
+			fmt.Sprintf("文件完整性基线已更新，共 %d 个文件", report.FileCount))
+	}
+
+	if len(report.Changes) == 0 {
+		im.server.alertManager.Resolve(clientID, integrityAlertKey, "所有文件与基线一致")
+		return
+	}
+
+	details := make([]string, 0, min(len(report.Changes), maxIntegrityAlertDetails+1))
+	for i, change := range report.Changes {
+		if i == maxIntegrityAlertDetails {
+			details = append(details, fmt.Sprintf("……以及其他 %d 个文件", len(report.Changes)-i))
+			break
+		}
+		details = append(details, models.FormatFileChange(change))
+	}
+	im.server.alertManager.Raise(clientID, integrityAlertKey, models.SeverityWarning,
+		fmt.Sprintf("%d 个文件与基线不一致", len(report.Changes)), details)
+}
+
+// GetReport 返回客户端最近一次的文件完整性监控结果，尚未收到时返回nil
+func (im *IntegrityManager) GetReport(clientID string) *proto.IntegrityReport {
+	return im.reports[clientID]
+}
+
+// RemoveClient 清除客户端的文件完整性监控结果
+func (im *IntegrityManager) RemoveClient(clientID string) {
+	delete(im.reports, clientID)
+}
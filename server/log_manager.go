@@ -0,0 +1,111 @@
+package main
+
+import (
+	"sort"
+	"time"
+
+	"GoMonitor/pkg/models"
+	"GoMonitor/proto"
+)
+
+// LogManager 保存客户端转发的日志，每个客户端按行数和保留时长淘汰最早的日志
+type LogManager struct {
+	server *Server
+	logs   map[string][]*models.LogEntry // client_id -> 按接收顺序排列的日志
+	seq    int64
+}
+
+// NewLogManager 创建日志管理器
+func NewLogManager(server *Server) *LogManager {
+	return &LogManager{
+		server: server,
+		logs:   make(map[string][]*models.LogEntry),
+	}
+}
+
+// Append 保存客户端转发的一批日志，返回新保存的日志
+func (lm *LogManager) Append(clientID string, lines []*proto.LogLine) []*models.LogEntry {
+	hostname := ""
+	if client, exists := lm.server.clients[clientID]; exists {
+		hostname = client.Hostname
+	}
+
+	now := time.Now()
+	added := make([]*models.LogEntry, 0, len(lines))
+	for _, line := range lines {
+		timestamp := now
+		if line.Timestamp > 0 {
+			timestamp = time.UnixMilli(line.Timestamp)
+		}
+		lm.seq++
+		added = append(added, &models.LogEntry{
+			Seq:       lm.seq,
+			ClientID:  clientID,
+			Hostname:  hostname,
+			Source:    line.Source,
+			Timestamp: timestamp,
+			Line:      line.Line,
+		})
+	}
+	lm.logs[clientID] = lm.trim(append(lm.logs[clientID], added...))
+	return added
+}
+
+// Trim 按当前的存储配置淘汰所有客户端的过期日志
+func (lm *LogManager) Trim() {
+	for clientID, entries := range lm.logs {
+		lm.logs[clientID] = lm.trim(entries)
+	}
+}
+
+// trim 淘汰超出行数限制和保留时长的日志
+func (lm *LogManager) trim(entries []*models.LogEntry) []*models.LogEntry {
+	start := 0
+	if overflow := len(entries) - lm.server.cfg.Storage.MaxLogLines; overflow > 0 {
+		start = overflow
+	}
+	if retention := lm.server.cfg.Storage.LogRetention; retention > 0 {
+		cutoff := time.Now().Add(-retention)
+		for start < len(entries) && entries[start].Timestamp.Before(cutoff) {
+			start++
+		}
+	}
+	if start == 0 {
+		return entries
+	}
+	return append([]*models.LogEntry(nil), entries[start:]...)
+}
+
+// Search 返回满足条件的日志，按接收顺序排列，有数量限制时保留最新的
+func (lm *LogManager) Search(query models.LogQuery, filter *models.LogFilter) []*models.LogEntry {
+	var cutoff time.Time
+	if retention := lm.server.cfg.Storage.LogRetention; retention > 0 {
+		cutoff = time.Now().Add(-retention)
+	}
+
+	matches := []*models.LogEntry{}
+	for clientID, entries := range lm.logs {
+		if query.ClientID != "" && clientID != query.ClientID {
+			continue
+		}
+		for _, entry := range entries {
+			if entry.Seq <= query.AfterSeq || entry.Timestamp.Before(cutoff) || !filter.Match(entry) {
+				continue
+			}
+			matches = append(matches, entry)
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].Seq < matches[j].Seq
+	})
+	if query.Limit > 0 && len(matches) > query.Limit {
+		matches = matches[len(matches)-query.Limit:]
+	}
+	return matches
+}
+
+// RemoveClient 清除客户端的日志
+func (lm *LogManager) RemoveClient(clientID string) {
+	delete(lm.logs, clientID)
+}
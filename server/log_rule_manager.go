@@ -0,0 +1,280 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"GoMonitor/pkg/config"
+	"GoMonitor/pkg/models"
+	"GoMonitor/proto"
+)
+
+// maxLogRuleAlertLines 是日志告警附加信息中最多列出的匹配行数（最新的）
+const maxLogRuleAlertLines = 10
+
+// compiledLogRule 是编译后的日志告警规则
+type compiledLogRule struct {
+	config.LogRule
+	filter *models.LogFilter
+}
+
+// logRuleState 是一条规则在一个客户端上的窗口状态
+// 窗口按服务端接收日志的时间计算，客户端补发的积压日志或时钟偏差不会使匹配立即过期
+type logRuleState struct {
+	times []time.Time    // 窗口内匹配行的接收时间，按时间排序
+	lines []logRuleMatch // 最近匹配的行
+}
+
+// logRuleMatch 是一行匹配的日志及其接收时间
+type logRuleMatch struct {
+	received time.Time
+	entry    *models.LogEntry
+}
+
+// LogRuleManager 评估日志告警规则
+// 服务端评估的规则在每个客户端上统计窗口内转发的匹配行数，达到阈值时触发告警，窗口内的匹配数低于阈值后恢复
+// 客户端评估的规则随客户端配置下发，按客户端上报的窗口内匹配数触发或恢复告警
+type LogRuleManager struct {
+	server *Server
+	rules  []*compiledLogRule
+	states map[string]map[string]*logRuleState // client_id -> 规则名称 -> 状态
+}
+
+// NewLogRuleManager 创建日志告警规则管理器
+func NewLogRuleManager(server *Server) *LogRuleManager {
+	return &LogRuleManager{
+		server: server,
+		states: make(map[string]map[string]*logRuleState),
+	}
+}
+
+// logRuleAlertKey 返回日志规则对应的告警标识
+func logRuleAlertKey(name string) string {
+	return "log:" + name
+}
+
+// logRuleErrorAlertKey 返回客户端无法评估规则时的告警标识
+func logRuleErrorAlertKey(name string) string {
+	return "log_error:" + name
+}
+
+// SetRules 应用新的规则，定义未变化的规则保留窗口状态
+// 已删除的规则恢复其告警；定义变化的规则重新开始统计，原有告警随之恢复
+func (lm *LogRuleManager) SetRules(rules []config.LogRule) {
+	previous := make(map[string]*compiledLogRule, len(lm.rules))
+	for _, rule := range lm.rules {
+		previous[rule.Name] = rule
+	}
+
+	compiled := make([]*compiledLogRule, 0, len(rules))
+	names := make(map[string]bool, len(rules))
+	for _, rule := range rules {
+		filter, err := models.LogQuery{Source: rule.Source, Pattern: rule.Pattern}.Compile()
+		if err != nil {
+			log.Printf("日志告警规则 %s 无效: %v", rule.Name, err)
+			continue
+		}
+		compiled = append(compiled, &compiledLogRule{LogRule: rule, filter: filter})
+		names[rule.Name] = true
+
+		if old, ok := previous[rule.Name]; ok && !reflect.DeepEqual(old.LogRule, rule) {
+			for _, states := range lm.states {
+				delete(states, rule.Name)
+			}
+		}
+	}
+	lm.rules = compiled
+
+	for _, states := range lm.states {
+		for name := range states {
+			if !names[name] {
+				delete(states, name)
+			}
+		}
+	}
+
+	onClient := make(map[string]bool)
+	for _, rule := range lm.rules {
+		onClient[rule.Name] = rule.EvaluatedOnClient()
+	}
+
+	for _, alert := range lm.server.alertManager.ListActive() {
+		if name, ok := strings.CutPrefix(alert.Key, logRuleAlertKey("")); ok && !names[name] {
+			lm.server.alertManager.Resolve(alert.ClientID, alert.Key, "日志告警规则已删除")
+		}
+		if name, ok := strings.CutPrefix(alert.Key, logRuleErrorAlertKey("")); ok && !onClient[name] {
+			lm.server.alertManager.Resolve(alert.ClientID, alert.Key, "日志告警规则已删除或不再由客户端评估")
+		}
+	}
+	lm.Expire()
+}
+
+// Evaluate 用客户端新转发的日志评估所有规则
+func (lm *LogRuleManager) Evaluate(clientID string, entries []*models.LogEntry) {
+	if len(lm.rules) == 0 || len(entries) == 0 {
+		return
+	}
+
+	states, exists := lm.states[clientID]
+	if !exists {
+		states = make(map[string]*logRuleState)
+		lm.states[clientID] = states
+	}
+
+	now := time.Now()
+	for _, rule := range lm.rules {
+		if rule.EvaluatedOnClient() {
+			continue
+		}
+		matched := false
+		for _, entry := range entries {
+			if !rule.filter.Match(entry) {
+				continue
+			}
+			state := states[rule.Name]
+			if state == nil {
+				state = &logRuleState{}
+				states[rule.Name] = state
+			}
+			state.times = append(state.times, now)
+			state.lines = append(state.lines, logRuleMatch{received: now, entry: entry})
+			if len(state.lines) > maxLogRuleAlertLines {
+				state.lines = state.lines[len(state.lines)-maxLogRuleAlertLines:]
+			}
+			matched = true
+		}
+		if matched {
+			lm.update(clientID, rule, now)
+		}
+	}
+}
+
+// Expire 淘汰窗口之外的匹配，匹配数低于阈值的告警恢复
+// 没有新日志时窗口也在移动，需要定期调用
+func (lm *LogRuleManager) Expire() {
+	now := time.Now()
+	for clientID := range lm.states {
+		for _, rule := range lm.rules {
+			if !rule.EvaluatedOnClient() {
+				lm.update(clientID, rule, now)
+			}
+		}
+	}
+}
+
+// UpdateResults 按客户端上报的评估结果触发或恢复客户端评估的规则的告警
+// 没有上报结果的规则（客户端尚未应用新配置）视为没有匹配；
+// 客户端报告无法评估的规则保持原告警状态，另外触发一条警告告知运维人员
+func (lm *LogRuleManager) UpdateResults(clientID string, results []*proto.LogRuleResult) {
+	reported := make(map[string]*proto.LogRuleResult, len(results))
+	for _, result := range results {
+		reported[result.Name] = result
+	}
+
+	hostname := ""
+	if client, exists := lm.server.clients[clientID]; exists {
+		hostname = client.Hostname
+	}
+
+	for _, rule := range lm.rules {
+		if !rule.EvaluatedOnClient() {
+			continue
+		}
+		key := logRuleAlertKey(rule.Name)
+		result := reported[rule.Name]
+		errKey := logRuleErrorAlertKey(rule.Name)
+		if result.GetError() != "" {
+			lm.server.alertManager.Raise(clientID, errKey, models.SeverityWarning,
+				fmt.Sprintf("日志告警规则 %s 在客户端无法评估: %s", rule.Name, result.Error), nil)
+			continue
+		}
+		lm.server.alertManager.Resolve(clientID, errKey, fmt.Sprintf("日志告警规则 %s 已恢复评估", rule.Name))
+
+		count := int(result.GetCount())
+		if count < rule.Threshold {
+			lm.server.alertManager.Resolve(clientID, key,
+				fmt.Sprintf("%s 已恢复: %v 内匹配 %d 行 (阈值 %d)", rule.Name, rule.Window, count, rule.Threshold))
+			continue
+		}
+
+		lines := result.GetLines()
+		if len(lines) > maxLogRuleAlertLines {
+			lines = lines[len(lines)-maxLogRuleAlertLines:]
+		}
+		details := make([]string, len(lines))
+		for i, line := range lines {
+			details[i] = models.FormatLogEntry(&models.LogEntry{
+				ClientID:  clientID,
+				Hostname:  hostname,
+				Source:    line.Source,
+				Timestamp: time.UnixMilli(line.Timestamp),
+				Line:      line.Line,
+			})
+		}
+		lm.server.alertManager.Raise(clientID, key, rule.Severity,
+			fmt.Sprintf("%s: %v 内有 %d 行日志匹配 %q (阈值 %d)", rule.Name, rule.Window, count, rule.Pattern, rule.Threshold), details)
+	}
+}
+
+// ClientRules 返回需要下发给客户端评估的规则
+func (lm *LogRuleManager) ClientRules() []*proto.LogRuleSpec {
+	var specs []*proto.LogRuleSpec
+	for _, rule := range lm.rules {
+		if rule.EvaluatedOnClient() {
+			specs = append(specs, &proto.LogRuleSpec{
+				Name:          rule.Name,
+				Pattern:       rule.Pattern,
+				Source:        rule.Source,
+				WindowSeconds: int32(rule.Window / time.Second),
+			})
+		}
+	}
+	return specs
+}
+
+// update 根据窗口内的匹配数触发或恢复告警
+func (lm *LogRuleManager) update(clientID string, rule *compiledLogRule, now time.Time) {
+	key := logRuleAlertKey(rule.Name)
+	state := lm.states[clientID][rule.Name]
+	if state == nil {
+		lm.server.alertManager.Resolve(clientID, key, fmt.Sprintf("%s 已恢复: %v 内没有匹配的日志", rule.Name, rule.Window))
+		return
+	}
+
+	cutoff := now.Add(-rule.Window)
+	expired := 0
+	for expired < len(state.times) && state.times[expired].Before(cutoff) {
+		expired++
+	}
+	state.times = state.times[expired:]
+	expired = 0
+	for expired < len(state.lines) && state.lines[expired].received.Before(cutoff) {
+		expired++
+	}
+	state.lines = state.lines[expired:]
+
+	count := len(state.times)
+	if count == 0 {
+		delete(lm.states[clientID], rule.Name)
+	}
+	if count < rule.Threshold {
+		lm.server.alertManager.Resolve(clientID, key,
+			fmt.Sprintf("%s 已恢复: %v 内匹配 %d 行 (阈值 %d)", rule.Name, rule.Window, count, rule.Threshold))
+		return
+	}
+
+	details := make([]string, len(state.lines))
+	for i, match := range state.lines {
+		details[i] = models.FormatLogEntry(match.entry)
+	}
+	lm.server.alertManager.Raise(clientID, key, rule.Severity,
+		fmt.Sprintf("%s: %v 内有 %d 行日志匹配 %q (阈值 %d)", rule.Name, rule.Window, count, rule.Pattern, rule.Threshold), details)
+}
+
+// RemoveClient 清除客户端的规则评估状态
+func (lm *LogRuleManager) RemoveClient(clientID string) {
+	delete(lm.states, clientID)
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"GoMonitor/pkg/config"
+	"GoMonitor/pkg/models"
+	"GoMonitor/proto"
+)
+
+func TestLogRuleWindowUsesReceiveTime(t *testing.T) {
+	s := NewServer(config.DefaultServerConfig())
+	s.logRuleMgr.SetRules([]config.LogRule{
+		{Name: "oom", Pattern: "Out of memory", Threshold: 2, Window: time.Minute, Severity: models.SeverityCritical},
+	})
+
+	// 补发的积压日志和时钟偏差的客户端时间戳都早于窗口，按接收时间仍在窗口内
+	stale := time.Now().Add(-time.Hour)
+	s.logRuleMgr.Evaluate("c1", []*models.LogEntry{
+		{ClientID: "c1", Source: "/var/log/kern.log", Timestamp: stale, Line: "Out of memory: Killed process 1"},
+		{ClientID: "c1", Source: "/var/log/kern.log", Timestamp: stale, Line: "Out of memory: Killed process 2"},
+		{ClientID: "c1", Source: "/var/log/kern.log", Timestamp: stale, Line: "unrelated"},
+	})
+	s.logRuleMgr.Expire()
+
+	alert := s.alertManager.active[alertKey("c1", logRuleAlertKey("oom"))]
+	if alert == nil {
+		t.Fatal("未触发告警")
+	}
+	if len(alert.Details) != 2 {
+		t.Fatalf("附带 %d 行，期望 2 行", len(alert.Details))
+	}
+
+	// 接收时间移出窗口后恢复
+	state := s.logRuleMgr.states["c1"]["oom"]
+	for i := range state.times {
+		state.times[i] = state.times[i].Add(-2 * time.Minute)
+		state.lines[i].received = state.lines[i].received.Add(-2 * time.Minute)
+	}
+	s.logRuleMgr.Expire()
+	if _, firing := s.alertManager.active[alertKey("c1", logRuleAlertKey("oom"))]; firing {
+		t.Fatal("窗口过后告警未恢复")
+	}
+}
+
+func TestLogRuleClientResults(t *testing.T) {
+	s := NewServer(config.DefaultServerConfig())
+	id, err := s.clientManager.RegisterClient(registerRequest("", "web-1"), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.logRuleMgr.SetRules([]config.LogRule{
+		{Name: "io", Pattern: "I/O error", Source: "journald", Threshold: 3, Window: time.Minute, Severity: models.SeverityWarning, Evaluate: config.LogRuleEvaluateClient},
+		{Name: "oom", Pattern: "Out of memory", Threshold: 1, Window: time.Minute, Severity: models.SeverityCritical},
+	})
+
+	specs := s.logRuleMgr.ClientRules()
+	if len(specs) != 1 || specs[0].Name != "io" || specs[0].WindowSeconds != 60 {
+		t.Fatalf("下发的规则 = %v", specs)
+	}
+	desired, err := s.agentCfgMgr.Resolve(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(desired.LogRules) != 1 {
+		t.Fatalf("客户端配置中有 %d 条日志规则，期望 1 条", len(desired.LogRules))
+	}
+
+	// 服务端不评估客户端规则
+	s.logRuleMgr.Evaluate(id, []*models.LogEntry{{ClientID: id, Source: "journald:kernel", Timestamp: time.Now(), Line: "I/O error"}})
+	if len(s.alertManager.ListActive()) != 0 {
+		t.Fatal("服务端评估了客户端规则")
+	}
+
+	key := alertKey(id, logRuleAlertKey("io"))
+	errKey := alertKey(id, logRuleErrorAlertKey("io"))
+	tests := []struct {
+		name      string
+		results   []*proto.LogRuleResult
+		firing    bool
+		errFiring bool
+	}{
+		{"低于阈值", []*proto.LogRuleResult{{Name: "io", Count: 2}}, false, false},
+		{"达到阈值", []*proto.LogRuleResult{{Name: "io", Count: 3, Lines: []*proto.LogLine{
+			{Source: "journald:kernel", Timestamp: time.Now().UnixMilli(), Line: "blk_update_request: I/O error"},
+		}}}, true, false},
+		// 客户端无法评估时不恢复原告警
+		{"客户端评估失败", []*proto.LogRuleResult{{Name: "io", Error: "journalctl: 未找到"}}, true, true},
+		{"未上报结果", nil, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s.logRuleMgr.UpdateResults(id, tt.results)
+			alert, firing := s.alertManager.active[key]
+			if firing != tt.firing {
+				t.Fatalf("告警 = %v, 期望 %v", firing, tt.firing)
+			}
+			if _, errFiring := s.alertManager.active[errKey]; errFiring != tt.errFiring {
+				t.Fatalf("评估失败告警 = %v, 期望 %v", errFiring, tt.errFiring)
+			}
+			if firing && len(alert.Details) != 1 {
+				t.Fatalf("附带 %d 行，期望 1 行", len(alert.Details))
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"fmt"
+
+	"GoMonitor/pkg/models"
+	"GoMonitor/proto"
+)
+
+// sshSessionMatchWindow 是SSH认证记录与wtmp登录记录视为同一次登录的最大时间差（秒）
+const sshSessionMatchWindow = 5
+
+// LoginManager 保存各客户端最近一次上报的登录审计信息，发现新的root登录时记录事件
+type LoginManager struct {
+	server *Server
+	logins map[string]*proto.LoginInfo    // client_id -> 最近一次的登录信息
+	seen   map[string]map[string]struct{} // client_id -> 已处理的root登录
+}
+
+// NewLoginManager 创建登录审计管理器
+func NewLoginManager(server *Server) *LoginManager {
+	return &LoginManager{
+		server: server,
+		logins: make(map[string]*proto.LoginInfo),
+		seen:   make(map[string]map[string]struct{}),
+	}
+}
+
+// Update 处理客户端上报的登录信息，本次未上报时保持原状态
+// 首次收到某个客户端的信息时只记录已有的root登录，不产生事件，避免服务端重启后重复报告
+func (lm *LoginManager) Update(clientID string, info *proto.LoginInfo) {
+	if info == nil {
+		return
+	}
+
+	_, known := lm.logins[clientID]
+	lm.logins[clientID] = info
+
+	logins := rootLogins(info)
+	seen := make(map[string]struct{}, len(logins))
+	for key, message := range logins {
+		seen[key] = struct{}{}
+		if _, ok := lm.seen[clientID][key]; known && !ok {
+			lm.server.eventManager.Record(clientID, "root_login", models.SeverityWarning, message)
+		}
+	}
+	lm.seen[clientID] = seen
+}
+
+// rootLogins 返回登录信息中所有root登录的标识和描述
+// 同一次SSH登录通常同时出现在wtmp和认证日志中，只保留wtmp中的记录；没有终端的SSH会话（如scp）只出现在认证日志中
+func rootLogins(info *proto.LoginInfo) map[string]string {
+	logins := make(map[string]string)
+	var sessions []*proto.LoginSession
+	for _, list := range [][]*proto.LoginSession{info.GetSessions(), info.GetHistory()} {
+		for _, session := range list {
+			if session.User != "root" {
+				continue
+			}
+			sessions = append(sessions, session)
+			key := fmt.Sprintf("session/%s/%d", session.Tty, session.LoginTime)
+			if session.Host != "" {
+				logins[key] = fmt.Sprintf("root 在 %s 从 %s 登录", session.Tty, session.Host)
+			} else {
+				logins[key] = fmt.Sprintf("root 在 %s 登录", session.Tty)
+			}
+		}
+	}
+
+	for _, login := range info.GetSshLogins() {
+		if login.User != "root" || matchesSession(login, sessions) {
+			continue
+		}
+		key := fmt.Sprintf("ssh/%s/%d", login.SourceIp, login.Timestamp)
+		logins[key] = fmt.Sprintf("root 通过SSH从 %s 登录 (%s)", login.SourceIp, login.Method)
+	}
+	return logins
+}
+
+// matchesSession 判断SSH认证记录是否对应某个登录会话
+func matchesSession(login *proto.SSHLogin, sessions []*proto.LoginSession) bool {
+	for _, session := range sessions {
+		diff := session.LoginTime - login.Timestamp
+		if session.Host == login.SourceIp && diff >= -sshSessionMatchWindow && diff <= sshSessionMatchWindow {
+			return true
+		}
+	}
+	return false
+}
+
+// GetLogins 返回客户端最近一次上报的登录信息，尚未收到时返回nil
+func (lm *LoginManager) GetLogins(clientID string) *proto.LoginInfo {
+	return lm.logins[clientID]
+}
+
+// RemoveClient 清除客户端的登录信息
+func (lm *LoginManager) RemoveClient(clientID string) {
+	delete(lm.logins, clientID)
+	delete(lm.seen, clientID)
+}
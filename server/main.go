@@ -1,31 +1,86 @@
 package main
 
 import (
-	"fmt"
+	"flag"
 	"log"
 	"net"
+	"os"
+	"os/signal"
+	"syscall"
 
+	"GoMonitor/pkg/config"
 	"GoMonitor/proto"
 	"GoMonitor/server/cli"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
+var configPath = flag.String("config", "", "配置文件路径（YAML）")
+
+// loadConfig 加载并校验服务端配置
+func loadConfig() (*config.ServerConfig, error) {
+	cfg, err := config.LoadServerConfig(*configPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
 func main() {
-	port := 50025
-	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	flag.Parse()
+
+	cfg, err := loadConfig()
+	if err != nil {
+		log.Fatalf("加载配置失败: %v", err)
+	}
+
+	lis, err := net.Listen("tcp", cfg.Listen)
 	if err != nil {
 		log.Fatalf("监听端口失败: %v", err)
 	}
 
-	s := grpc.NewServer()
-	serverImpl := NewServer()
+	var opts []grpc.ServerOption
+	if cfg.TLS.Enabled {
+		tlsConfig, err := cfg.TLS.Build()
+		if err != nil {
+			log.Fatalf("加载TLS配置失败: %v", err)
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
+	s := grpc.NewServer(opts...)
+	serverImpl := NewServer(cfg)
 	proto.RegisterSystemInfoServiceServer(s, serverImpl)
 
-	log.Printf("服务器启动，监听端口: %d", port)
+	log.Printf("服务器启动，监听地址: %s", cfg.Listen)
 
+	go watchReload(serverImpl)
+	go serverImpl.StartClientExpiry()
+	go serverImpl.StartLogRuleExpiry()
 	go cli.RunCommandLine(serverImpl)
 
 	if err := s.Serve(lis); err != nil {
 		log.Fatalf("服务启动失败: %v", err)
 	}
 }
+
+// watchReload 收到SIGHUP时重新加载配置
+func watchReload(s *Server) {
+	reload := make(chan os.Signal, 1)
+	signal.Notify(reload, syscall.SIGHUP)
+
+	for range reload {
+		cfg, err := loadConfig()
+		if err != nil {
+			log.Printf("重新加载配置失败，继续使用原配置: %v", err)
+			continue
+		}
+
+		s.ApplyConfig(cfg)
+		log.Printf("配置已重新加载")
+	}
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"GoMonitor/pkg/config"
+	"GoMonitor/pkg/models"
+)
+
+// Notifier 负责把告警变化写入日志并推送到webhook
+type Notifier struct {
+	client *http.Client
+}
+
+// NewNotifier 创建通知器
+func NewNotifier() *Notifier {
+	return &Notifier{
+		client: &http.Client{},
+	}
+}
+
+// alertNotification 是推送给webhook的JSON内容
+type alertNotification struct {
+	Status     string    `json:"status"` // firing 或 resolved
+	AlertID    string    `json:"alert_id"`
+	ClientID   string    `json:"client_id"`
+	Hostname   string    `json:"hostname"`
+	Key        string    `json:"key"`
+	Severity   string    `json:"severity"`
+	Message    string    `json:"message"`
+	Details    []string  `json:"details,omitempty"`
+	StartedAt  time.Time `json:"started_at"`
+	ResolvedAt time.Time `json:"resolved_at,omitempty"`
+}
+
+// Notify 记录告警变化并异步推送到所有webhook，推送失败只记录日志
+func (n *Notifier) Notify(cfg config.NotificationConfig, alert *models.Alert) {
+	status := "firing"
+	if !alert.Firing {
+		status = "resolved"
+	}
+
+	log.Printf("告警[%s/%s] 客户端 %s (%s) %s: %s",
+		status, alert.Severity, alert.ClientID, alert.Hostname, alert.Key, alert.Message)
+
+	if len(cfg.Webhooks) == 0 {
+		return
+	}
+
+	payload := alertNotification{
+		Status:    status,
+		AlertID:   alert.ID,
+		ClientID:  alert.ClientID,
+		Hostname:  alert.Hostname,
+		Key:       alert.Key,
+		Severity:  alert.Severity,
+		Message:   alert.Message,
+		Details:   alert.Details,
+		StartedAt: alert.StartedAt,
+	}
+	if !alert.Firing {
+		payload.ResolvedAt = alert.ResolvedAt
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		log.Printf("序列化告警通知失败: %v", err)
+		return
+	}
+
+	for _, webhook := range cfg.Webhooks {
+		go func(webhook string) {
+			if err := n.post(webhook, body, cfg.Timeout); err != nil {
+				log.Printf("推送告警通知到 %s 失败: %v", webhook, err)
+			}
+		}(webhook)
+	}
+}
+
+func (n *Notifier) post(webhook string, body []byte, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("HTTP状态码 %d", resp.StatusCode)
+	}
+	return nil
+}
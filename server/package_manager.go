@@ -0,0 +1,182 @@
+package main
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"GoMonitor/pkg/models"
+	"GoMonitor/pkg/utils"
+	"GoMonitor/proto"
+)
+
+// PackageManager 保存各客户端已安装的软件包，应用客户端上报的增量并记录变化历史
+type PackageManager struct {
+	server   *Server
+	packages map[string]map[string]*proto.Package // client_id -> 软件包标识 -> 软件包
+	digests  map[string]string                    // client_id -> 当前列表的摘要
+	history  map[string][]*models.PackageChange   // client_id -> 软件包变化历史
+}
+
+// NewPackageManager 创建软件包管理器
+func NewPackageManager(server *Server) *PackageManager {
+	return &PackageManager{
+		server:   server,
+		packages: make(map[string]map[string]*proto.Package),
+		digests:  make(map[string]string),
+		history:  make(map[string][]*models.PackageChange),
+	}
+}
+
+// Update 应用客户端上报的软件包清单，返回是否需要客户端重新上报完整列表
+// 增量所基于的列表与服务端保存的不一致（例如服务端重启或上一次上报丢失）时丢弃增量并要求重新同步；
+// 与已保存列表相同的重复上报（采集器结果被缓存）直接忽略
+// 首次收到完整列表只记录基线，之后的完整列表与已保存的列表比较并记录变化
+func (pm *PackageManager) Update(clientID string, inv *proto.PackageInventory) bool {
+	if inv == nil {
+		return false
+	}
+
+	previous, known := pm.packages[clientID]
+	if known && inv.Digest == pm.digests[clientID] {
+		return false
+	}
+
+	var current map[string]*proto.Package
+	if inv.Full {
+		current = make(map[string]*proto.Package, len(inv.Packages))
+		for _, pkg := range inv.Packages {
+			current[models.PackageKey(pkg)] = pkg
+		}
+	} else {
+		if !known || inv.BaseDigest != pm.digests[clientID] {
+			return true
+		}
+		current = make(map[string]*proto.Package, len(previous))
+		for key, pkg := range previous {
+			current[key] = pkg
+		}
+		for _, pkg := range inv.Removed {
+			delete(current, models.PackageKey(pkg))
+		}
+		for _, pkg := range inv.Packages {
+			current[models.PackageKey(pkg)] = pkg
+		}
+	}
+
+	// 应用增量后的结果与客户端的列表不一致，说明双方的基线已经不同
+	if models.PackageDigest(current) != inv.Digest {
+		return !inv.Full
+	}
+
+	pm.packages[clientID] = current
+	pm.digests[clientID] = inv.Digest
+	if known {
+		pm.recordChanges(clientID, previous, current)
+	}
+	return false
+}
+
+// recordChanges 比较前后两份列表，记录安装、卸载和版本变化
+func (pm *PackageManager) recordChanges(clientID string, previous, current map[string]*proto.Package) {
+	now := time.Now()
+	var changes []*models.PackageChange
+
+	for key, pkg := range current {
+		old, existed := previous[key]
+		if existed && old.Version == pkg.Version {
+			continue
+		}
+		change := &models.PackageChange{
+			Timestamp:  now,
+			Manager:    pkg.Manager,
+			Name:       pkg.Name,
+			Arch:       pkg.Arch,
+			NewVersion: pkg.Version,
+		}
+		if existed {
+			change.OldVersion = old.Version
+		}
+		changes = append(changes, change)
+	}
+	for key, pkg := range previous {
+		if _, ok := current[key]; !ok {
+			changes = append(changes, &models.PackageChange{
+				Timestamp:  now,
+				Manager:    pkg.Manager,
+				Name:       pkg.Name,
+				Arch:       pkg.Arch,
+				OldVersion: pkg.Version,
+			})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Name < changes[j].Name
+	})
+
+	history := append(pm.history[clientID], changes...)
+	if overflow := len(history) - pm.server.cfg.Storage.MaxPackageHistory; overflow > 0 {
+		history = append([]*models.PackageChange(nil), history[overflow:]...)
+	}
+	pm.history[clientID] = history
+}
+
+// Search 在所有客户端中查找名称匹配且版本以versionPrefix开头的软件包，versionPrefix为空时不限版本
+func (pm *PackageManager) Search(name *utils.Matcher, versionPrefix string) []*models.PackageMatch {
+	var matches []*models.PackageMatch
+	for clientID, packages := range pm.packages {
+		client, exists := pm.server.clients[clientID]
+		if !exists {
+			continue
+		}
+		for _, pkg := range packages {
+			if !name.Match(pkg.Name) || !strings.HasPrefix(pkg.Version, versionPrefix) {
+				continue
+			}
+			matches = append(matches, &models.PackageMatch{
+				ClientID: clientID,
+				Hostname: client.Hostname,
+				Package:  pkg,
+			})
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if a.Package.Name != b.Package.Name {
+			return a.Package.Name < b.Package.Name
+		}
+		if a.Package.Version != b.Package.Version {
+			return a.Package.Version < b.Package.Version
+		}
+		return a.Hostname < b.Hostname
+	})
+	return matches
+}
+
+// GetHistory 返回客户端的软件包变化历史，最新的在前
+func (pm *PackageManager) GetHistory(clientID string) []*models.PackageChange {
+	history := pm.history[clientID]
+	result := make([]*models.PackageChange, len(history))
+	for i, change := range history {
+		result[len(history)-1-i] = change
+	}
+	return result
+}
+
+// TrimHistory 按新的保留数量裁剪所有客户端的历史
+func (pm *PackageManager) TrimHistory(max int) {
+	for clientID, history := range pm.history {
+		if overflow := len(history) - max; overflow > 0 {
+			pm.history[clientID] = append([]*models.PackageChange(nil), history[overflow:]...)
+		}
+	}
+}
+
+// RemoveClient 清除客户端的软件包和历史
+func (pm *PackageManager) RemoveClient(clientID string) {
+	delete(pm.packages, clientID)
+	delete(pm.digests, clientID)
+	delete(pm.history, clientID)
+}
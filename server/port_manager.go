@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+
+	"GoMonitor/pkg/models"
+	"GoMonitor/proto"
+)
+
+// PortManager 跟踪各客户端的监听端口，端口新开放或关闭时记录事件
+type PortManager struct {
+	server    *Server
+	listening map[string]map[string]*proto.ListeningSocket // client_id -> 端口标识 -> 监听套接字
+}
+
+// NewPortManager 创建监听端口管理器
+func NewPortManager(server *Server) *PortManager {
+	return &PortManager{
+		server:    server,
+		listening: make(map[string]map[string]*proto.ListeningSocket),
+	}
+}
+
+// listeningKey 返回监听套接字的标识，例如 "tcp 0.0.0.0:22"
+func listeningKey(socket *proto.ListeningSocket) string {
+	return socket.Protocol + " " + net.JoinHostPort(socket.Address, strconv.Itoa(int(socket.Port)))
+}
+
+// Update 处理客户端上报的套接字信息
+// 首次上报只记录基线；之后新出现的监听端口记录为warning事件，消失的记录为info事件
+// 本次未上报套接字信息（采集器被禁用或失败）时保持原状态
+func (pm *PortManager) Update(clientID string, info *proto.ConnectionInfo) {
+	if info == nil {
+		return
+	}
+
+	current := make(map[string]*proto.ListeningSocket, len(info.Listening))
+	for _, socket := range info.Listening {
+		current[listeningKey(socket)] = socket
+	}
+
+	previous, known := pm.listening[clientID]
+	pm.listening[clientID] = current
+	if !known {
+		return
+	}
+
+	for key, socket := range current {
+		if _, ok := previous[key]; !ok {
+			pm.server.eventManager.Record(clientID, "port_opened", models.SeverityWarning,
+				fmt.Sprintf("新开放监听端口 %s，进程: %s", key, formatSocketOwner(socket)))
+		}
+	}
+	for key, socket := range previous {
+		if _, ok := current[key]; !ok {
+			pm.server.eventManager.Record(clientID, "port_closed", models.SeverityInfo,
+				fmt.Sprintf("监听端口 %s 已关闭，原进程: %s", key, formatSocketOwner(socket)))
+		}
+	}
+}
+
+// formatSocketOwner 格式化套接字所属进程，无法确定时返回"未知"
+func formatSocketOwner(socket *proto.ListeningSocket) string {
+	if socket.Pid == 0 {
+		return "未知"
+	}
+	return fmt.Sprintf("%s (%d)", socket.Process, socket.Pid)
+}
+
+// RemoveClient 清除客户端的监听端口记录
+func (pm *PortManager) RemoveClient(clientID string) {
+	delete(pm.listening, clientID)
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"GoMonitor/pkg/config"
+	"GoMonitor/pkg/models"
+	"GoMonitor/proto"
+)
+
+// RuleManager 在每次收到系统信息时评估指标阈值告警规则
+type RuleManager struct {
+	server  *Server
+	pending map[string]map[string]time.Time // client_id -> 规则名称 -> 开始满足条件的时间
+}
+
+// NewRuleManager 创建告警规则管理器
+func NewRuleManager(server *Server) *RuleManager {
+	return &RuleManager{
+		server:  server,
+		pending: make(map[string]map[string]time.Time),
+	}
+}
+
+// ruleAlertKey 返回规则对应的告警标识
+func ruleAlertKey(name string) string {
+	return "rule:" + name
+}
+
+// Evaluate 用客户端最新的系统信息评估所有规则
+// 本次上报中没有对应指标的规则保持原状态，避免采集器短暂失败导致告警反复恢复和触发
+func (rm *RuleManager) Evaluate(clientID string, info *proto.SystemInfo) {
+	pending, exists := rm.pending[clientID]
+	if !exists {
+		pending = make(map[string]time.Time)
+		rm.pending[clientID] = pending
+	}
+
+	now := time.Now()
+	for _, rule := range rm.server.cfg.AlertRules {
+		value, ok := models.MetricValue(info, rule.Metric)
+		if !ok {
+			continue
+		}
+
+		key := ruleAlertKey(rule.Name)
+		if !rule.Matches(value) {
+			delete(pending, rule.Name)
+			rm.server.alertManager.Resolve(clientID, key,
+				fmt.Sprintf("%s 已恢复: %s = %.2f", rule.Name, rule.Metric, value))
+			continue
+		}
+
+		since, ok := pending[rule.Name]
+		if !ok {
+			since = now
+			pending[rule.Name] = since
+		}
+		if now.Sub(since) < rule.For {
+			continue
+		}
+
+		rm.server.alertManager.Raise(clientID, key, rule.Severity, formatRuleMessage(rule, value), nil)
+	}
+}
+
+// formatRuleMessage 生成告警内容，例如 "memory_low: memory.available_percent = 4.20 (< 5)"
+func formatRuleMessage(rule config.AlertRule, value float64) string {
+	return fmt.Sprintf("%s: %s = %.2f (%s %g)", rule.Name, rule.Metric, value, rule.Operator, rule.Threshold)
+}
+
+// Prune 在规则重新加载后清理已删除规则的评估状态，并恢复它们的告警
+func (rm *RuleManager) Prune() {
+	rules := make(map[string]bool)
+	for _, rule := range rm.server.cfg.AlertRules {
+		rules[rule.Name] = true
+	}
+
+	for _, pending := range rm.pending {
+		for name := range pending {
+			if !rules[name] {
+				delete(pending, name)
+			}
+		}
+	}
+
+	for _, alert := range rm.server.alertManager.ListActive() {
+		if name, ok := strings.CutPrefix(alert.Key, ruleAlertKey("")); ok && !rules[name] {
+			rm.server.alertManager.Resolve(alert.ClientID, alert.Key, "告警规则已删除")
+		}
+	}
+}
+
+// RemoveClient 清除客户端的规则评估状态
+func (rm *RuleManager) RemoveClient(clientID string) {
+	delete(rm.pending, clientID)
+}
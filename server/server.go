@@ -1,11 +1,19 @@
 package main
 
 import (
+	"GoMonitor/pkg/config"
 	"GoMonitor/pkg/models"
+	"GoMonitor/pkg/utils"
 	"GoMonitor/proto"
 	"context"
+	"fmt"
 	"log"
+	"net"
 	"sync"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 )
 
 // Server 是gRPC服务的主要实现
@@ -16,27 +24,140 @@ type Server struct {
 	clientStreams map[string]proto.SystemInfoService_ReceiveCommandsServer
 	clientManager *ClientManager
 	cmdManager    *CommandManager
+	agentCfgMgr   *AgentConfigManager
+	eventManager  *EventManager
+	alertManager  *AlertManager
+	checkManager  *CheckManager
+	ruleManager   *RuleManager
+	portManager   *PortManager
+	pkgManager    *PackageManager
+	advisoryMgr   *AdvisoryManager
+	integrityMgr  *IntegrityManager
+	logManager    *LogManager
+	logRuleMgr    *LogRuleManager
+	loginManager  *LoginManager
+	accountMgr    *AccountManager
+	cronManager   *CronManager
+	cfg           *config.ServerConfig
 }
 
 // NewServer 创建一个新的服务器实例
-func NewServer() *Server {
+func NewServer(cfg *config.ServerConfig) *Server {
 	server := &Server{
+		cfg:           cfg,
 		clients:       make(map[string]*models.ClientInfo),
 		clientStreams: make(map[string]proto.SystemInfoService_ReceiveCommandsServer),
 	}
 
 	server.clientManager = NewClientManager(server)
 	server.cmdManager = NewCommandManager(server)
+	server.agentCfgMgr = NewAgentConfigManager(server)
+	server.eventManager = NewEventManager(server)
+	server.alertManager = NewAlertManager(server)
+	server.checkManager = NewCheckManager(server)
+	server.ruleManager = NewRuleManager(server)
+	server.portManager = NewPortManager(server)
+	server.pkgManager = NewPackageManager(server)
+	server.advisoryMgr = NewAdvisoryManager(server)
+	server.integrityMgr = NewIntegrityManager(server)
+	server.logManager = NewLogManager(server)
+	server.logRuleMgr = NewLogRuleManager(server)
+	server.logRuleMgr.SetRules(cfg.LogRules)
+	server.loginManager = NewLoginManager(server)
+	server.accountMgr = NewAccountManager(server)
+	server.cronManager = NewCronManager(server)
+
+	if len(cfg.Advisories.Paths) > 0 {
+		index, err := loadAdvisories(cfg.Advisories.Paths)
+		if err != nil {
+			log.Printf("加载安全公告失败: %v", err)
+		} else {
+			server.advisoryMgr.SetIndex(index)
+			log.Printf("已加载 %d 条安全公告", index.count)
+		}
+	}
 
 	return server
 }
 
+// ApplyConfig 应用重新加载的配置
+// 存储、命令策略和告警规则立即生效；安全公告重新加载，加载失败时保留原有公告；监听地址和TLS需要重启服务端
+func (s *Server) ApplyConfig(cfg *config.ServerConfig) {
+	// 公告文件可能很大，在加锁前读取
+	var index *advisoryIndex
+	if len(cfg.Advisories.Paths) > 0 {
+		var err error
+		if index, err = loadAdvisories(cfg.Advisories.Paths); err != nil {
+			log.Printf("重新加载安全公告失败，继续使用原有公告: %v", err)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cfg.Listen != cfg.Listen || s.cfg.TLS != cfg.TLS {
+		log.Printf("监听地址或TLS配置已变更，需要重启服务端才能生效")
+	}
+
+	s.cfg = cfg
+	s.cmdManager.TrimCommandResults(cfg.Storage.MaxCommandResults)
+	s.checkManager.TrimHistory(cfg.Storage.MaxCheckHistory)
+	s.pkgManager.TrimHistory(cfg.Storage.MaxPackageHistory)
+	s.logManager.Trim()
+	s.ruleManager.Prune()
+	s.logRuleMgr.SetRules(cfg.LogRules)
+	s.agentCfgMgr.RefreshAll()
+
+	switch {
+	case index != nil:
+		s.advisoryMgr.SetIndex(index)
+		log.Printf("已加载 %d 条安全公告", index.count)
+	case len(cfg.Advisories.Paths) == 0:
+		s.advisoryMgr.SetIndex(newAdvisoryIndex())
+	default:
+		// 告警级别可能已变化
+		s.advisoryMgr.EvaluateAll()
+	}
+}
+
+// StartClientExpiry 定期移除长时间未上报的客户端
+func (s *Server) StartClientExpiry() {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		s.mu.Lock()
+		expiry := s.cfg.Storage.ClientExpiry
+		if expiry > 0 {
+			for _, client := range s.clientManager.ListClients() {
+				if time.Since(client.LastSeen) > expiry {
+					log.Printf("客户端 %s (%s) 超过 %v 未上报，已移除", client.ID, client.Hostname, expiry)
+					s.clientManager.RemoveClient(client.ID)
+				}
+			}
+		}
+		s.mu.Unlock()
+	}
+}
+
+// StartLogRuleExpiry 定期移动日志告警规则的统计窗口，使不再出现匹配日志的告警能够恢复
+func (s *Server) StartLogRuleExpiry() {
+	ticker := time.NewTicker(30 * time.Second)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		s.mu.Lock()
+		s.logRuleMgr.Expire()
+		s.mu.Unlock()
+	}
+}
+
 // Register 处理客户端注册请求
 func (s *Server) Register(ctx context.Context, req *proto.RegisterRequest) (*proto.RegisterResponse, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	clientID, err := s.clientManager.RegisterClient(req)
+	clientID, err := s.clientManager.RegisterClient(req, peerHost(ctx))
 	if err != nil {
 		return &proto.RegisterResponse{
 			Success: false,
@@ -44,7 +165,11 @@ func (s *Server) Register(ctx context.Context, req *proto.RegisterRequest) (*pro
 		}, err
 	}
 
-	log.Printf("客户端注册: ID=%s, 主机名=%s, IP=%s", clientID, req.Hostname, req.IpAddress)
+	log.Printf("客户端注册: ID=%s, 主机名=%s, IP=%s, 观测地址=%s", clientID, req.Hostname, req.IpAddress, peerHost(ctx))
+
+	if err := s.agentCfgMgr.Refresh(clientID); err != nil {
+		log.Printf("计算客户端 %s 的配置失败: %v", clientID, err)
+	}
 
 	return &proto.RegisterResponse{
 		ClientId: clientID,
@@ -59,25 +184,84 @@ func (s *Server) SendSystemInfo(ctx context.Context, req *proto.SystemInfoReques
 	defer s.mu.Unlock()
 
 	clientID := req.ClientId
-	err := s.clientManager.UpdateClientInfo(clientID, req.SystemInfo)
+	err := s.clientManager.UpdateClientInfo(clientID, req)
 	if err != nil {
 		return &proto.SystemInfoResponse{
 			Received: false,
 			Message:  err.Error(),
 		}, err
 	}
+	s.clientManager.ObservePeer(clientID, peerHost(ctx))
+	s.clientManager.UpdateInventory(clientID, req.Inventory)
 
-	cpuUsage := req.SystemInfo.CpuInfo.CpuUsagePercent
-	memUsage := req.SystemInfo.MemoryInfo.MemoryUsagePercent
-	log.Printf("收到客户端 %s 的系统信息: CPU使用率=%.2f%%, 内存使用率=%.2f%%",
-		clientID, cpuUsage, memUsage)
+	cpuUsage := req.GetSystemInfo().GetCpuInfo().GetCpuUsagePercent()
+	memUsage := req.GetSystemInfo().GetMemoryInfo().GetMemoryUsagePercent()
+	load1, _, _ := models.LoadAverages(req.GetSystemInfo().GetCpuInfo())
+	log.Printf("收到客户端 %s 的系统信息: CPU使用率=%.2f%%, 负载=%.2f, 内存使用率=%.2f%%",
+		clientID, cpuUsage, load1, memUsage)
+
+	for _, collectorErr := range req.GetSystemInfo().GetCollectorErrors() {
+		log.Printf("客户端 %s 的采集器 %s 失败: %s", clientID, collectorErr.Collector, collectorErr.Error)
+	}
+
+	s.checkManager.Update(clientID, req.GetSystemInfo().GetCheckResults())
+	s.ruleManager.Evaluate(clientID, req.GetSystemInfo())
+	s.portManager.Update(clientID, req.GetSystemInfo().GetConnectionInfo())
+	resync := s.pkgManager.Update(clientID, req.GetSystemInfo().GetPackages())
+	if resync {
+		log.Printf("客户端 %s 的软件包增量无法应用，要求重新上报完整列表", clientID)
+	}
+	s.advisoryMgr.Evaluate(clientID)
+	s.integrityMgr.Update(clientID, req.GetSystemInfo().GetIntegrity())
+	s.loginManager.Update(clientID, req.GetSystemInfo().GetLogins())
+	s.accountMgr.Update(clientID, req.GetSystemInfo().GetAccounts())
+	s.cronManager.Update(clientID, req.GetSystemInfo().GetScheduledJobs())
+	s.logRuleMgr.UpdateResults(clientID, req.GetSystemInfo().GetLogRuleResults())
 
 	return &proto.SystemInfoResponse{
+		Received:       true,
+		Message:        "信息已接收",
+		ResyncPackages: resync,
+	}, nil
+}
+
+// SendLogs 处理客户端转发的日志
+func (s *Server) SendLogs(ctx context.Context, batch *proto.LogBatch) (*proto.LogBatchResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	clientID := batch.ClientId
+	if err := s.clientManager.ValidateClient(clientID); err != nil {
+		return &proto.LogBatchResponse{
+			Received: false,
+			Message:  err.Error(),
+		}, err
+	}
+	s.clientManager.ObservePeer(clientID, peerHost(ctx))
+
+	entries := s.logManager.Append(clientID, batch.Lines)
+	s.logRuleMgr.Evaluate(clientID, entries)
+
+	return &proto.LogBatchResponse{
 		Received: true,
-		Message:  "信息已接收",
+		Message:  fmt.Sprintf("已接收 %d 行日志", len(batch.Lines)),
 	}, nil
 }
 
+// peerHost 返回RPC对端的IP地址（不含端口），无法获取时返回空字符串
+func peerHost(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
 // ReceiveCommands 建立命令流
 func (s *Server) ReceiveCommands(req *proto.CommandRequest, stream proto.SystemInfoService_ReceiveCommandsServer) error {
 	clientID := req.ClientId
@@ -86,8 +270,17 @@ func (s *Server) ReceiveCommands(req *proto.CommandRequest, stream proto.SystemI
 		return err
 	}
 
+	// 立即发送响应头，让客户端确认命令流已建立
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+
 	s.mu.Lock()
 	s.clientStreams[clientID] = stream
+	s.clientManager.ObservePeer(clientID, peerHost(stream.Context()))
+
+	// 先下发配置，保证客户端在执行其他命令前已应用最新策略
+	s.agentCfgMgr.Push(clientID)
 
 	pendingCommands := s.cmdManager.GetPendingCommands(clientID)
 	s.mu.Unlock()
@@ -103,7 +296,10 @@ func (s *Server) ReceiveCommands(req *proto.CommandRequest, stream proto.SystemI
 	<-stream.Context().Done()
 
 	s.mu.Lock()
-	delete(s.clientStreams, clientID)
+	// 客户端重连后可能已经建立了新的流，只清理属于本次连接的流
+	if current, ok := s.clientStreams[clientID]; ok && current == stream {
+		delete(s.clientStreams, clientID)
+	}
 	s.mu.Unlock()
 
 	log.Printf("客户端 %s 的命令流已断开", clientID)
@@ -125,7 +321,9 @@ func (s *Server) ReportCommandResult(ctx context.Context, result *proto.CommandR
 		}, err
 	}
 
-	if err := s.cmdManager.SaveCommandResult(clientID, cmdID, result); err != nil {
+	s.clientManager.ObservePeer(clientID, peerHost(ctx))
+
+	if err := s.cmdManager.SaveCommandResult(clientID, cmdID, result, s.cfg.Storage.MaxCommandResults); err != nil {
 		return &proto.CommandResultResponse{
 			Received: false,
 			Message:  err.Error(),
@@ -145,13 +343,38 @@ func (s *Server) ReportCommandResult(ctx context.Context, result *proto.CommandR
 	}, nil
 }
 
+// AckConfig 处理客户端对下发配置的确认
+func (s *Server) AckConfig(ctx context.Context, ack *proto.ConfigAck) (*proto.ConfigAckResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.agentCfgMgr.HandleAck(ack); err != nil {
+		return &proto.ConfigAckResponse{Received: false}, err
+	}
+	s.clientManager.ObservePeer(ack.ClientId, peerHost(ctx))
+
+	if ack.Success {
+		log.Printf("客户端 %s 已应用配置版本 %d", ack.ClientId, ack.Version)
+	} else {
+		log.Printf("客户端 %s 应用配置版本 %d 失败: %s", ack.ClientId, ack.Version, ack.Error)
+	}
+
+	return &proto.ConfigAckResponse{Received: true}, nil
+}
+
 // 获取客户端列表
 func (s *Server) ListClients() []*models.ClientInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	return s.clientManager.ListClients()
 }
 
 // 获取客户端信息
 func (s *Server) GetClientInfo(clientID string) (*models.ClientInfo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	return s.clientManager.GetClientInfo(clientID)
 }
 
@@ -160,6 +383,19 @@ func (s *Server) SendCommandToClient(clientID string, cmdType string, content st
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	if !s.cfg.Policies.Allows(cmdType) {
+		return "", fmt.Errorf("命令类型 %s 被服务端策略禁止", cmdType)
+	}
+
+	// 客户端最终会拒绝其生效配置不允许的命令，在服务端提前拒绝
+	allowed, err := s.agentCfgMgr.CommandAllowed(clientID, cmdType)
+	if err != nil {
+		return "", err
+	}
+	if !allowed {
+		return "", fmt.Errorf("命令类型 %s 被客户端 %s 的配置禁止", cmdType, clientID)
+	}
+
 	cmdID, err := s.cmdManager.CreateCommand(clientID, cmdType, content, timeout)
 	if err != nil {
 		return "", err
@@ -181,7 +417,170 @@ func (s *Server) SendCommandToClient(clientID string, cmdType string, content st
 	return cmdID, nil
 }
 
+// 为客户端设置覆盖配置并下发
+func (s *Server) SetClientConfigOverride(clientID string, overlay config.AgentConfigOverlay) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.agentCfgMgr.SetOverride(clientID, overlay)
+}
+
+// 获取客户端的检查状态和状态变化历史
+func (s *Server) GetCheckStates(clientID string) (map[string]*models.CheckStatus, []*models.CheckStateChange, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.clientManager.ValidateClient(clientID); err != nil {
+		return nil, nil, err
+	}
+
+	states := make(map[string]*models.CheckStatus)
+	for name, status := range s.checkManager.GetStates(clientID) {
+		copied := *status
+		states[name] = &copied
+	}
+	return states, s.checkManager.GetHistory(clientID), nil
+}
+
+// 在所有客户端中搜索软件包，name为通配符或 "re:" 开头的正则表达式
+func (s *Server) SearchPackages(name string, versionPrefix string) ([]*models.PackageMatch, error) {
+	matcher, err := utils.NewMatcher([]string{name}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.pkgManager.Search(matcher, versionPrefix), nil
+}
+
+// 获取客户端的软件包变化历史
+func (s *Server) GetPackageHistory(clientID string) ([]*models.PackageChange, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.clientManager.ValidateClient(clientID); err != nil {
+		return nil, err
+	}
+	return s.pkgManager.GetHistory(clientID), nil
+}
+
+// 获取客户端命中的安全公告
+func (s *Server) GetVulnerabilities(clientID string) ([]*models.VulnerabilityFinding, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.clientManager.ValidateClient(clientID); err != nil {
+		return nil, err
+	}
+	return s.advisoryMgr.GetFindings(clientID), nil
+}
+
+// 获取所有客户端的漏洞汇总
+func (s *Server) GetVulnerabilityReport() *models.VulnerabilityReport {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.advisoryMgr.Report()
+}
+
+// 获取客户端最近一次上报的文件完整性监控结果，尚未收到时返回nil
+func (s *Server) GetIntegrityReport(clientID string) (*proto.IntegrityReport, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.clientManager.ValidateClient(clientID); err != nil {
+		return nil, err
+	}
+	return s.integrityMgr.GetReport(clientID), nil
+}
+
+// 获取客户端最近一次上报的登录会话和认证统计，尚未收到时返回nil
+func (s *Server) GetLogins(clientID string) (*proto.LoginInfo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.clientManager.ValidateClient(clientID); err != nil {
+		return nil, err
+	}
+	return s.loginManager.GetLogins(clientID), nil
+}
+
+// 获取客户端最近一次上报的本地账户和组，尚未收到时返回nil
+func (s *Server) GetAccounts(clientID string) (*proto.AccountInventory, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.clientManager.ValidateClient(clientID); err != nil {
+		return nil, err
+	}
+	return s.accountMgr.GetAccounts(clientID), nil
+}
+
+// 列出所有客户端上拥有root权限（UID 0或可通过sudo以root执行任意命令）的账户
+func (s *Server) ListRootAccess() []*models.RootAccess {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.accountMgr.RootAccess()
+}
+
+// 列出所有客户端上命令、用户或文件匹配的计划任务，pattern为空时列出全部
+func (s *Server) SearchScheduledJobs(pattern string) ([]*models.ScheduledJobMatch, error) {
+	var include []string
+	if pattern != "" {
+		include = []string{pattern}
+	}
+	matcher, err := utils.NewMatcher(include, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.cronManager.Search(matcher), nil
+}
+
+// 搜索客户端转发的日志；query.AfterSeq 为上次结果中最大的序号时可用于实时跟踪
+func (s *Server) SearchLogs(query models.LogQuery) ([]*models.LogEntry, error) {
+	filter, err := query.Compile()
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if query.ClientID != "" {
+		if err := s.clientManager.ValidateClient(query.ClientID); err != nil {
+			return nil, err
+		}
+	}
+	return s.logManager.Search(query, filter), nil
+}
+
+// 获取触发中的告警和最近恢复的告警
+func (s *Server) ListAlerts(resolvedLimit int) ([]*models.Alert, []*models.Alert) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.alertManager.ListActive(), s.alertManager.ListResolved(resolvedLimit)
+}
+
+// 获取最近的事件，clientID为空时返回所有客户端的事件
+func (s *Server) ListEvents(clientID string, limit int) []*models.Event {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.eventManager.List(clientID, limit)
+}
+
 // 获取命令执行结果
 func (s *Server) GetCommandResult(cmdID string) (*proto.CommandResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	return s.cmdManager.GetCommandResult(cmdID)
 }